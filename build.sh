@@ -1,6 +1,6 @@
 echo "======= Converting Header Files"
 cd raylib-convert
-go run main.go
+go run .
 
 echo "======= Copying Generated Files"
 echo "Audio";  	cp out/audio_gen.go ../raylib/audio_gen.go
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+//callbackDefinition is a C function pointer typedef, such as TraceLogCallback
+type callbackDefinition struct {
+	name      string
+	comment   string
+	returnArg argument
+	args      []*argument
+}
+
+var reCallback = regexp.MustCompile(`^typedef (const |unsigned )?([a-zA-Z0-9]+) (\**)\s*\(\s*\*\s*([a-zA-Z0-9]+)\s*\)\s*\(([^)]*)\);\s*(//(.*))?`)
+
+//isCallbackTypedef checks if the line is a function pointer typedef
+func isCallbackTypedef(line string) bool {
+	return reCallback.MatchString(line)
+}
+
+//parseCallback parses a function pointer typedef
+func parseCallback(line string) (*callbackDefinition, error) {
+	matches := reCallback.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, errors.New("invalid callback typedef")
+	}
+
+	def := &callbackDefinition{
+		name:    matches[4],
+		comment: strings.Trim(matches[7], " /"),
+		returnArg: argument{
+			name:         "return",
+			constant:     strings.TrimSpace(matches[1]) == "const",
+			unsigned:     strings.TrimSpace(matches[1]) == "unsigned",
+			valueType:    matches[2],
+			pointerDepth: len(matches[3]),
+		},
+		args: make([]*argument, 0),
+	}
+
+	reArgument := regexp.MustCompile(`^(const |unsigned )?([a-zA-Z0-9_]+) (\**)([a-zA-Z0-9]+)$`)
+	for _, part := range strings.Split(matches[5], ",") {
+		part = strings.TrimSpace(part)
+		if part == "void" || part == "" {
+			continue
+		}
+
+		arg := reArgument.FindStringSubmatch(part)
+		if arg == nil {
+			return nil, errors.New("cannot process callback argument " + part)
+		}
+
+		if arg[2] == "va_list" {
+			return nil, errors.New("cannot process va_list callback arguments")
+		}
+
+		name := arg[4]
+		if name == "type" || name == "interface" || name == "return" {
+			name = "g" + name
+		}
+
+		def.args = append(def.args, &argument{
+			entire:       arg[0],
+			constant:     strings.TrimSpace(arg[1]) == "const",
+			unsigned:     strings.TrimSpace(arg[1]) == "unsigned",
+			valueType:    arg[2],
+			pointerDepth: len(arg[3]),
+			name:         name,
+		})
+	}
+
+	return def, nil
+}
+
+//translateCallback creates a Go function type for the callback, along with a variable holding the current callback and an
+// exported function that converts the arguments and calls it. The exported function must be declared with //conv:cgo
+// before it can be passed to C, such as "extern void goTraceLogCallback(int, char *);".
+func translateCallback(def *callbackDefinition) (string, error) {
+	if def.returnArg.valueType != "void" || def.returnArg.HasPointer() {
+		if def.returnArg.HasPointer() || !isBasicType(def.returnArg.valueType) {
+			return "", errors.New("cannot process callbacks returning " + def.returnArg.valueType)
+		}
+	}
+
+	goArgs := make([]string, len(def.args))
+	cArgs := make([]string, len(def.args))
+	callArgs := make([]string, len(def.args))
+	for i, arg := range def.args {
+		if arg.GetPraticalPointerDepth() > 0 {
+			return "", errors.New("cannot process pointer callback arguments")
+		}
+
+		goType := convertType(arg.valueType, arg.unsigned)
+		cType := "C." + arg.valueType
+		if arg.unsigned {
+			cType = "C.u" + arg.valueType
+		}
+		if arg.valueType == "char" {
+			cType = "*C.char"
+		}
+		if arg.valueType == "void" {
+			cType = "unsafe.Pointer"
+		}
+
+		goArgs[i] = arg.name + " " + goType
+		cArgs[i] = arg.name + " " + cType
+		callArgs[i] = castToGo(arg.name, arg.valueType, arg.HasPointer(), arg.unsigned)
+	}
+
+	goReturn, cReturn := "", ""
+	if def.returnArg.valueType != "void" {
+		goReturn = " " + convertType(def.returnArg.valueType, def.returnArg.unsigned)
+		cReturn = " C." + def.returnArg.valueType
+		if def.returnArg.unsigned {
+			cReturn = " C.u" + def.returnArg.valueType
+		}
+	}
+
+	current := "current" + def.name
+	trampoline := "go" + def.name
+
+	comment := def.comment
+	if comment == "" {
+		comment = def.name + " callback"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "//%s : %s\n", def.name, comment)
+	fmt.Fprintf(&sb, "type %s func(%s)%s\n\n", def.name, strings.Join(goArgs, ", "), goReturn)
+	fmt.Fprintf(&sb, "//%s is the callback called by %s\n", current, trampoline)
+	fmt.Fprintf(&sb, "var %s %s\n\n", current, def.name)
+	fmt.Fprintf(&sb, "//export %s\n", trampoline)
+	fmt.Fprintf(&sb, "func %s(%s)%s {\n", trampoline, strings.Join(cArgs, ", "), cReturn)
+
+	call := fmt.Sprintf("%s(%s)", current, strings.Join(callArgs, ", "))
+	if def.returnArg.valueType == "void" {
+		fmt.Fprintf(&sb, "if %s != nil {\n%s\n}\n", current, call)
+	} else {
+		zero := "0"
+		if def.returnArg.valueType == "bool" {
+			zero = "false"
+		}
+		fmt.Fprintf(&sb, "if %s == nil {\nreturn %s\n}\n", current, zero)
+		fmt.Fprintf(&sb, "return%s(%s)\n", cReturn, call)
+	}
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
+//isBasicType checks if the type is a number or bool that can be converted directly
+func isBasicType(t string) bool {
+	switch t {
+	case "int", "float", "double", "bool", "char", "short", "long":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+)
+
+//translateCallbackLine parses and translates a callback typedef, returning the formatted Go source
+func translateCallbackLine(t *testing.T, line string) string {
+	t.Helper()
+	if !isCallbackTypedef(line) {
+		t.Fatalf("%q is not detected as a callback typedef", line)
+	}
+
+	def, err := parseCallback(line)
+	if err != nil {
+		t.Fatalf("parseCallback(%q) failed: %v", line, err)
+	}
+	translation, err := translateCallback(def)
+	if err != nil {
+		t.Fatalf("translateCallback(%q) failed: %v", line, err)
+	}
+
+	formatted, err := formatSource(translation)
+	if err != nil {
+		t.Fatalf("translation of %q is not valid Go: %v\n%s", line, err, translation)
+	}
+	return formatted
+}
+
+func TestTranslateCallback(t *testing.T) {
+	actual := translateCallbackLine(t, "typedef void (*TraceLogCallback)(int logType, const char *text); // Logging callback")
+	expected, _ := formatSource(`//TraceLogCallback : Logging callback
+type TraceLogCallback func(logType int, text string)
+
+//currentTraceLogCallback is the callback called by goTraceLogCallback
+var currentTraceLogCallback TraceLogCallback
+
+//export goTraceLogCallback
+func goTraceLogCallback(logType C.int, text *C.char) {
+	if currentTraceLogCallback != nil {
+		currentTraceLogCallback(int(int32(logType)), C.GoString(text))
+	}
+}`)
+	if actual != expected {
+		t.Errorf("translateCallback() =\n%s\nwant\n%s", actual, expected)
+	}
+}
+
+func TestTranslateCallbackReturn(t *testing.T) {
+	actual := translateCallbackLine(t, "typedef float (*EaseCallback)(float t, unsigned int step);")
+	expected, _ := formatSource(`//EaseCallback : EaseCallback callback
+type EaseCallback func(t float32, step uint32) float32
+
+//currentEaseCallback is the callback called by goEaseCallback
+var currentEaseCallback EaseCallback
+
+//export goEaseCallback
+func goEaseCallback(t C.float, step C.uint) C.float {
+	if currentEaseCallback == nil {
+		return 0
+	}
+	return C.float(currentEaseCallback(float32(t), uint32(step)))
+}`)
+	if actual != expected {
+		t.Errorf("translateCallback() =\n%s\nwant\n%s", actual, expected)
+	}
+}
+
+func TestTranslateCallbackErrors(t *testing.T) {
+	tests := []string{
+		"typedef void (*TraceLogCallback)(int logType, const char *text, va_list args);",
+		"typedef void (*PointsCallback)(Vector2 *points, int count);",
+		"typedef Vector2 (*PositionCallback)(void);",
+	}
+
+	for _, line := range tests {
+		def, err := parseCallback(line)
+		if err == nil {
+			_, err = translateCallback(def)
+		}
+		if err == nil {
+			t.Errorf("%q translated, want an error", line)
+		}
+	}
+}
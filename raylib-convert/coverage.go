@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//coverageReport lists which functions of the raylib header have bindings
+type coverageReport struct {
+	Header   string   `json:"header"`
+	Total    int      `json:"total"`
+	Bound    int      `json:"bound"`
+	Coverage float64  `json:"coverage"`
+	Unbound  []string `json:"unbound"`
+}
+
+var rlapiFunctionPattern = regexp.MustCompile(`^\s*RLAPI\s+[^(]*?(\w+)\s*\(`)
+var cCallPattern = regexp.MustCompile(`\bC\.(\w+)\(`)
+var preamblePattern = regexp.MustCompile(`/\*((?:[^*]|\*[^/])*)\*/\s*import\s+"C"`)
+var preambleCallPattern = regexp.MustCompile(`\b(\w+)\s*\(`)
+
+//runCoverage compares the functions in the raylib header to the C functions called by the bindings,
+// then prints the report and writes it as JSON to the output directory.
+func runCoverage(headerFile, sourceDir, jsonFile string) error {
+	header, err := os.Open(headerFile)
+	if err != nil {
+		return err
+	}
+	defer header.Close()
+
+	functions, err := parseHeaderFunctions(header)
+	if err != nil {
+		return err
+	}
+
+	bound, err := findBoundFunctions(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	//Manual files are named after the function they replace, and may not call it at all
+	manuals, _ := filepath.Glob(filepath.Join(*manualDir, "*.go"))
+	for _, manual := range manuals {
+		bound[strings.TrimSuffix(filepath.Base(manual), ".go")] = true
+	}
+
+	report := buildCoverageReport(headerFile, functions, bound)
+	fmt.Print(report.String())
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	os.MkdirAll(filepath.Dir(jsonFile), os.ModePerm)
+	return ioutil.WriteFile(jsonFile, data, 0644)
+}
+
+//parseHeaderFunctions finds the name of every RLAPI function declared in the header
+func parseHeaderFunctions(reader io.Reader) ([]string, error) {
+	functions := make([]string, 0)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		//Skip the macros that define RLAPI
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		if match := rlapiFunctionPattern.FindStringSubmatch(line); match != nil {
+			functions = append(functions, match[1])
+		}
+	}
+	return functions, scanner.Err()
+}
+
+//findBoundFunctions finds every C function that is called by the Go files in the directories.
+// This includes the functions called from C helpers in the cgo preambles, such as the Go_ wrappers.
+func findBoundFunctions(dirs ...string) (map[string]bool, error) {
+	bound := make(map[string]bool)
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			for _, match := range cCallPattern.FindAllStringSubmatch(string(data), -1) {
+				bound[match[1]] = true
+			}
+
+			//Anything that looks like a call in the preamble counts, as only names in the header are checked against it
+			for _, preamble := range preamblePattern.FindAllStringSubmatch(string(data), -1) {
+				for _, match := range preambleCallPattern.FindAllStringSubmatch(preamble[1], -1) {
+					bound[match[1]] = true
+				}
+			}
+		}
+	}
+	return bound, nil
+}
+
+//buildCoverageReport works out which of the functions are not bound
+func buildCoverageReport(header string, functions []string, bound map[string]bool) coverageReport {
+	report := coverageReport{Header: header, Unbound: make([]string, 0)}
+
+	seen := make(map[string]bool)
+	for _, function := range functions {
+		if seen[function] {
+			continue
+		}
+		seen[function] = true
+
+		report.Total++
+		if bound[function] {
+			report.Bound++
+		} else {
+			report.Unbound = append(report.Unbound, function)
+		}
+	}
+
+	sort.Strings(report.Unbound)
+	if report.Total > 0 {
+		report.Coverage = float64(report.Bound) / float64(report.Total) * 100
+	}
+	return report
+}
+
+//String formats the report as text
+func (report coverageReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Coverage of %s: %d / %d functions (%.1f%%)\n", report.Header, report.Bound, report.Total, report.Coverage)
+	if len(report.Unbound) > 0 {
+		fmt.Fprintf(&sb, "Unbound functions:\n")
+		for _, function := range report.Unbound {
+			fmt.Fprintf(&sb, "\t%s\n", function)
+		}
+	}
+	return sb.String()
+}
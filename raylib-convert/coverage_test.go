@@ -0,0 +1,91 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const coverageTestHeader = `#define RLAPI extern
+// Window-related functions
+RLAPI void InitWindow(int width, int height, const char *title);  // Initialize window and OpenGL context
+RLAPI bool WindowShouldClose(void);                               // Check if KEY_ESCAPE pressed or Close icon pressed
+RLAPI const char *GetMonitorName(int monitor);                    // Get the human-readable name of the monitor
+RLAPI void CloseWindow(void);                                     // Close window and unload OpenGL context
+RLAPI void InitWindow(int width, int height, const char *title);  // Declared twice
+`
+
+func TestParseHeaderFunctions(t *testing.T) {
+	functions, err := parseHeaderFunctions(strings.NewReader(coverageTestHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"InitWindow", "WindowShouldClose", "GetMonitorName", "CloseWindow", "InitWindow"}
+	if !reflect.DeepEqual(functions, expected) {
+		t.Errorf("parseHeaderFunctions() = %v, want %v", functions, expected)
+	}
+}
+
+func TestBuildCoverageReport(t *testing.T) {
+	dir := t.TempDir()
+	source := "package raylib\nfunc InitWindow() { C.InitWindow(0, 0, nil) }\nfunc CloseWindow() { C.CloseWindow() }\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "main_gen.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	functions, err := parseHeaderFunctions(strings.NewReader(coverageTestHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound, err := findBoundFunctions(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := buildCoverageReport("raylib.h", functions, bound)
+	if report.Total != 4 || report.Bound != 2 {
+		t.Errorf("report has %d / %d bound, want 2 / 4", report.Bound, report.Total)
+	}
+	if report.Coverage != 50 {
+		t.Errorf("report coverage is %v, want 50", report.Coverage)
+	}
+
+	expected := []string{"GetMonitorName", "WindowShouldClose"}
+	if !reflect.DeepEqual(report.Unbound, expected) {
+		t.Errorf("report unbound = %v, want %v", report.Unbound, expected)
+	}
+
+	text := report.String()
+	if !strings.Contains(text, "2 / 4 functions (50.0%)") || !strings.Contains(text, "\tWindowShouldClose\n") {
+		t.Errorf("report text is missing the summary or unbound functions:\n%s", text)
+	}
+}
+
+func TestBuildCoverageReportEmpty(t *testing.T) {
+	report := buildCoverageReport("empty.h", nil, map[string]bool{})
+	if report.Total != 0 || report.Coverage != 0 || report.Unbound == nil || len(report.Unbound) != 0 {
+		t.Errorf("empty report = %+v, want no functions and an empty unbound list", report)
+	}
+}
+
+func TestFindBoundFunctionsPreamble(t *testing.T) {
+	dir := t.TempDir()
+	source := "package raylib\n\n/*\n#include \"raylib.h\"\n\nstatic void Go_Close(void) {\n\tCloseWindow();\n}\n*/\nimport \"C\"\n\nfunc Close() { C.Go_Close() }\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "close.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bound, err := findBoundFunctions(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bound["CloseWindow"] {
+		t.Errorf("CloseWindow called from the preamble is not bound: %v", bound)
+	}
+	if !bound["Go_Close"] {
+		t.Errorf("Go_Close called from Go is not bound: %v", bound)
+	}
+}
@@ -25,9 +25,49 @@ var (
 	functionalConvert = flag.Bool("use_func", true, "tells the converter to use newTypeFromPointer and cptr() functions")
 	oopOnly           = flag.Bool("oop_only", false, "should only the OOP version of the function be generated?")
 	trackUnloadables  = flag.Bool("track_unloadables", true, "should unloadables track when they are being loaded and unloaded to our list. Only applicable with OOP")
+	coverageHeader    = flag.String("coverage", "", "report which functions of this raylib header are not bound, instead of converting")
+	coverageSource    = flag.String("coverage_src", "../raylib/", "the directory of bindings the coverage report checks")
 )
 
 var ignoreOOPs []string
+
+//wrapperTypes are the structs that have a new<Type>FromPointer helper, used to convert returned C structs back into Go.
+// The value is true if the helper returns a pointer to the struct, or false if it returns a copy of the value.
+// Structs that are not listed are converted with an unsafe cast instead.
+var wrapperTypes = map[string]bool{
+	"Vector2":         false,
+	"Vector3":         false,
+	"Vector4":         false,
+	"Transform":       false,
+	"Matrix":          false,
+	"Color":           false,
+	"Rectangle":       false,
+	"BoundingBox":     false,
+	"NPatchInfo":      false,
+	"CharInfo":        false,
+	"Ray":             false,
+	"RayHitInfo":      false,
+	"Texture2D":       false,
+	"RenderTexture2D": false,
+	"Shader":          false,
+	"GuiTextBoxState": false,
+	"Image":           true,
+	"Font":            true,
+	"Wave":            true,
+	"Sound":           true,
+	"Music":           true,
+	"AudioStream":     true,
+	"Mesh":            true,
+	"Model":           true,
+	"ModelAnimation":  true,
+	"Material":        true,
+	"MaterialMap":     true,
+	"Camera":          true,
+	"Camera3D":        true,
+	"Camera2D":        true,
+	"TextureCubemap":  true,
+	"VrDeviceInfo":    true,
+}
 var patterns []matchPattern
 var enums []matchEnum
 
@@ -54,6 +94,14 @@ func main() {
 	//Parse the flags
 	flag.Parse()
 
+	//Report the coverage instead of converting
+	if *coverageHeader != "" {
+		if err := runCoverage(*coverageHeader, *coverageSource, *output+"/coverage.json"); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	//Read the ignore FileExists
 	ifile, ierr := os.Open(*ignoreOOPFile)
 	ignoreOOPs = make([]string, 0)
@@ -95,6 +143,10 @@ func main() {
 	ignoring := false
 	asOOP := false
 
+	//Struct typedefs span several lines, so they are collected until they are closed
+	var structLines []string
+	lastComment := ""
+
 	//Read each line of the input file and generate the prototypes
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
@@ -165,6 +217,53 @@ func main() {
 			continue
 		}
 
+		//Collect the struct until it is closed, then translate it
+		if structLines != nil {
+			structLines = append(structLines, line)
+			if isStructEnd(line) {
+				def, serr := parseStruct(structLines, lastComment)
+				if serr == nil {
+					success = append(success, translateStruct(def))
+					successTally++
+				} else {
+					fmt.Println("Failed: ", structLines[0])
+					failed = append(failed, "\n//"+serr.Error()+"\n"+strings.Join(structLines, "\n"))
+					failureTally++
+				}
+				structLines = nil
+			}
+			continue
+		}
+
+		if isStructStart(line) {
+			structLines = []string{line}
+			continue
+		}
+
+		//Function pointer typedefs become Go function types
+		if isCallbackTypedef(line) {
+			def, cerr := parseCallback(line)
+			translation := ""
+			if cerr == nil {
+				translation, cerr = translateCallback(def)
+			}
+
+			if cerr == nil {
+				success = append(success, translation)
+				successTally++
+			} else {
+				fmt.Println("Failed: ", line)
+				failed = append(failed, "\n//"+cerr.Error()+"\n"+line)
+				failureTally++
+			}
+			continue
+		}
+
+		//Remember the comment, as it describes the struct that follows
+		if strings.HasPrefix(line, "//") {
+			lastComment = line
+		}
+
 		//Process the line
 		p, err := parseLine(line)
 
@@ -226,25 +325,32 @@ func saveProgress(filenameFailed string, filenameSuccess string, successResults
 		} else {
 			ioutil.WriteFile(*output+"/"+filenameSuccess, out.Bytes(), 0644)
 		}
+	} else {
+		ioutil.WriteFile(*output+"/"+filenameSuccess, []byte(successResults), 0644)
 	}
-
 }
 
 func translatePrototype(prototype *prototype, objectOriented bool) (string, error) {
 
 	//We have a manual definition, so use that instead
 	if _, err := os.Stat(*manualDir + prototype.name + ".go"); err == nil {
+		//End with a newline like the generated bindings, so the next binding is not joined onto the end of it
 		bt, fe := ioutil.ReadFile(*manualDir + prototype.name + ".go")
-		return "\n" + string(bt), fe
+		return "\n" + strings.TrimRight(string(bt), "\n") + "\n", fe
 	}
 
-	//We do not support return types really yet, but when we do we have a special case for pointers
+	//Go cannot call C variadic functions, so they must be bridged by hand
+	if prototype.variadic {
+		return "", fmt.Errorf("variadic functions need a manual binding in %s%s.go", *manualDir, prototype.name)
+	}
+
+	//Value return types are cast back into Go, but pointers need to be written manually as we dont know their length
 	if prototype.returnArg.GetPraticalPointerDepth() >= 1 {
 		return "", errors.New("cannot process pointer return types")
 	}
 
 	isOOP := false
-	if len(prototype.args) >= 1 && prototype.args[0] != nil &&
+	if len(prototype.args) >= 1 && prototype.args[0] != nil && !prototype.args[0].isArray &&
 		isObject(prototype.args[0].valueType) && objectOriented &&
 		!strings.Contains(prototype.name, "Load") &&
 		!contains(ignoreOOPs, prototype.args[0].valueType) {
@@ -263,11 +369,12 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 
 	body := "C." + prototype.name + "("
 
-	//Add the first item to the return headers
+	//Add the first item to the return headers. Void functions have no return at all.
 	if prototype.returnArg.valueType != "void" {
 		spacing := " "
 
-		if isReferencedObject(prototype.returnArg.valueType) {
+		//Known wrappers decide if they are returned as a pointer, everything else is returned by value
+		if wrapperTypes[prototype.returnArg.valueType] {
 			spacing = " *"
 			returnPointer = true
 		}
@@ -288,6 +395,27 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 			return "", errors.New("cannot process pointer of pointer arg types")
 		}
 
+		//Arrays are passed as slices, and their count is taken from the slice
+		if arg.isArray {
+			bodyArgPart, bodyPrefixPart, err := castArrayToC(*arg)
+			if err != nil {
+				return "", err
+			}
+
+			argNames[i] = arg.name
+			argHeaders[i] = arg.name + " []" + convertType(arg.valueType, arg.unsigned)
+			bodyArgs[bodyArgsTally] = bodyArgPart
+			bodyArgsTally++
+			body = bodyPrefixPart + "\n" + body
+			continue
+		}
+
+		if arg.countOf != "" {
+			bodyArgs[bodyArgsTally] = "C.int(int32(len(" + arg.countOf + ")))"
+			bodyArgsTally++
+			continue
+		}
+
 		spacing := " "
 		if isReferencedObject(arg.valueType) && objectOriented {
 			spacing = " *"
@@ -329,10 +457,12 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 		}
 	}
 
-	//Prepare the return headers
+	//Counts of arrays are not part of the Go function, so remove their gaps
+	argHeaders = removeEmpty(argHeaders)
+	argNames = removeEmpty(argNames)
 
 	//Finish the body and add everythign back
-	body = body + strings.Join(bodyArgs, ", ") + ")"
+	body = body + strings.Join(bodyArgs[:bodyArgsTally], ", ") + ")"
 	returnFooter := ""
 	if len(returnExpre) > 0 {
 
@@ -381,7 +511,7 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 
 		//add the comment and the line
 		definition += "//" + oopName + " : " + prototype.comment + "\n"
-		definition += fmt.Sprintf("func (%s) %s(%s) (%s) {\n %s \n}\n", retName, oopName, strings.Join(argHeaders[1:], ", "), strings.Join(returnHeaders, ", "), body)
+		definition += fmt.Sprintf("func (%s) %s(%s)%s {\n %s \n}\n", retName, oopName, strings.Join(argHeaders[1:], ", "), formatReturnHeaders(returnHeaders), body)
 	}
 
 	if !*oopOnly || !isOOP {
@@ -403,12 +533,28 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 		}
 
 		//Add the definition
-		definition += fmt.Sprintf("func %s(%s) (%s) {\n %s \n}\n", prototype.name, strings.Join(argHeaders, ", "), strings.Join(returnHeaders, ", "), body)
+		definition += fmt.Sprintf("func %s(%s)%s {\n %s \n}\n", prototype.name, strings.Join(argHeaders, ", "), formatReturnHeaders(returnHeaders), body)
 	}
 
 	return definition, nil
 }
 
+//formatReturnHeaders formats the return types of a function. Void functions have no header, single returns
+// are left bare and multiple returns are wrapped in brackets.
+func formatReturnHeaders(returnHeaders []string) string {
+	switch len(returnHeaders) {
+	case 0:
+		return ""
+	case 1:
+		return " " + strings.TrimSpace(returnHeaders[0])
+	default:
+		for i, header := range returnHeaders {
+			returnHeaders[i] = strings.TrimSpace(header)
+		}
+		return " (" + strings.Join(returnHeaders, ", ") + ")"
+	}
+}
+
 //castType creates a cast for a type, returning first the name of the variable and then the definition of the variable.
 // There are some cases where there is no definition.
 func castToC(a argument) (string, string, bool) {
@@ -457,6 +603,46 @@ func castToC(a argument) (string, string, bool) {
 	}
 }
 
+//castArrayToC creates a pointer to the first element of a slice, returning the name of the variable and its definition.
+// Empty slices are passed as NULL.
+func castArrayToC(a argument) (string, string, error) {
+	csname := "c" + a.name
+
+	switch a.valueType {
+	default:
+		if *functionalConvert {
+			return csname, fmt.Sprintf("var %s *C.%s\nif len(%s) > 0 {\n%s = %s[0].cptr()\n}", csname, a.valueType, a.name, csname, a.name), nil
+		}
+		return csname, fmt.Sprintf("var %s *C.%s\nif len(%s) > 0 {\n%s = (*C.%s)(unsafe.Pointer(&%s[0]))\n}", csname, a.valueType, a.name, csname, a.valueType, a.name), nil
+
+	case "float":
+		return csname, fmt.Sprintf("var %s *C.float\nif len(%s) > 0 {\n%s = (*C.float)(unsafe.Pointer(&%s[0]))\n}", csname, a.name, csname, a.name), nil
+
+	case "int":
+		//Go ints are not the same size as C ints, so they need to be copied into a new array
+		ctype := "C.int"
+		if a.unsigned {
+			ctype = "C.uint"
+		}
+		return csname, fmt.Sprintf("var %s *%s\nif len(%s) > 0 {\n%sarr := make([]%s, len(%s))\nfor i, v := range %s {\n%sarr[i] = %s(v)\n}\n%s = &%sarr[0]\n}",
+			csname, ctype, a.name, csname, ctype, a.name, a.name, csname, ctype, csname, csname), nil
+
+	case "bool", "double", "uint8":
+		return "", "", errors.New("cannot process arrays of " + a.valueType)
+	}
+}
+
+//removeEmpty removes the empty strings from the slice
+func removeEmpty(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 //casts a c type to a go type
 func castToGo(variable, t string, isPointer bool, unsigned bool) string {
 	addr := ""
@@ -484,7 +670,7 @@ func castToGo(variable, t string, isPointer bool, unsigned bool) string {
 		return "unsafe.Pointer(" + addr + variable + ")"
 	default:
 		//func newRectangleFromPointer(ptr unsafe.Pointer) Rectangle { return *(*Rectangle)(ptr) }
-		if *functionalConvert {
+		if _, ok := wrapperTypes[t]; ok && *functionalConvert {
 			return "new" + convertType(t, unsigned) + "FromPointer(unsafe.Pointer(" + addr + variable + "))"
 		}
 
@@ -585,6 +771,12 @@ func parseLine(line string) (*prototype, error) {
 	arguments := make([]*argument, len(parts))
 	i := 0
 	for _, p := range parts {
+		//C varargs cannot be converted, but are remembered so the function can be routed to a manual file
+		if strings.TrimSpace(p) == "..." {
+			proto.variadic = true
+			continue
+		}
+
 		matches := reArgument.FindAllStringSubmatch(p, -1)
 
 		if len(matches) != 1 {
@@ -623,16 +815,41 @@ func parseLine(line string) (*prototype, error) {
 		i++
 	}
 
-	proto.args = arguments
+	markArrayArguments(arguments)
+	proto.args = arguments[:i]
 	return proto, nil
 }
 
+//markArrayArguments finds pointers that are immediately followed by their count, such as `Vector2 *points, int pointsCount`.
+// These become a single slice in Go, with the count taken from the length of the slice.
+func markArrayArguments(arguments []*argument) {
+	for i := 0; i+1 < len(arguments); i++ {
+		array, count := arguments[i], arguments[i+1]
+		if array == nil || count == nil {
+			continue
+		}
+
+		if array.GetPraticalPointerDepth() != 1 || array.valueType == "char" || array.valueType == "void" {
+			continue
+		}
+
+		if count.valueType != "int" || count.HasPointer() || (count.name != "count" && !strings.HasSuffix(count.name, "Count")) {
+			continue
+		}
+
+		array.isArray = true
+		count.countOf = array.name
+		i++
+	}
+}
+
 type prototype struct {
 	entire    string
 	name      string
 	args      []*argument
 	returnArg argument
 	comment   string
+	variadic  bool //The function takes C varargs (...)
 }
 
 type argument struct {
@@ -643,6 +860,8 @@ type argument struct {
 	pointerDepth int
 	constant     bool
 	unsigned     bool
+	isArray      bool   //The argument is a pointer to the first element of an array
+	countOf      string //The argument is the length of the array with this name
 }
 
 func (p *argument) HasPointer() bool { return p.pointerDepth > 0 }
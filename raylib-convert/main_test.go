@@ -0,0 +1,224 @@
+package main
+
+import (
+	gofmt "go/format"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+//convertLine parses a single header line and translates it, returning the formatted Go source.
+// Manual files are ignored so the generated output is always tested.
+func convertLine(t *testing.T, line string, objectOriented bool) string {
+	t.Helper()
+
+	previousManual := *manualDir
+	*manualDir = t.TempDir() + "/"
+	defer func() { *manualDir = previousManual }()
+
+	patterns = make([]matchPattern, 0)
+	enums = make([]matchEnum, 0)
+	ignoreOOPs = make([]string, 0)
+
+	proto, err := parseLine(line)
+	if err != nil {
+		t.Fatalf("parseLine(%q) failed: %v", line, err)
+	}
+	if proto == nil {
+		t.Fatalf("parseLine(%q) returned no prototype", line)
+	}
+
+	translation, err := translatePrototype(proto, objectOriented)
+	if err != nil {
+		t.Fatalf("translatePrototype(%q) failed: %v", line, err)
+	}
+
+	formatted, err := formatSource(translation)
+	if err != nil {
+		t.Fatalf("translation of %q is not valid Go: %v\n%s", line, err, translation)
+	}
+	return formatted
+}
+
+//formatSource runs gofmt over a snippet of the raylib package
+func formatSource(source string) (string, error) {
+	formatted, err := gofmt.Source([]byte("package raylib\n" + source))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(strings.TrimPrefix(string(formatted), "package raylib\n")), nil
+}
+
+//assertTranslation checks the generated source matches. Both are formatted first, so only the code has to match.
+func assertTranslation(t *testing.T, line string, objectOriented bool, expected string) {
+	t.Helper()
+	actual := convertLine(t, line, objectOriented)
+	expected, err := formatSource(expected)
+	if err != nil {
+		t.Fatalf("expected translation is not valid Go: %v", err)
+	}
+	if actual != expected {
+		t.Errorf("translation of %q\n got:\n%s\nwant:\n%s", line, actual, expected)
+	}
+}
+
+func TestConvertFloatReturn(t *testing.T) {
+	assertTranslation(t, "RLAPI float Bar(int x); // does stuff", false, `
+//Bar : does stuff
+func Bar(x int) float32 {
+	res := C.Bar(C.int(int32(x)))
+	return float32(res)
+}`)
+}
+
+func TestConvertStructReturns(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{
+			"RLAPI Vector2 GetMousePosition(void);                         // Returns mouse position XY",
+			`//GetMousePosition : Returns mouse position XY
+func GetMousePosition() Vector2 {
+	res := C.GetMousePosition()
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}`,
+		},
+		{
+			"RLAPI Color GetColor(int hexValue);                               // Returns a Color struct from hexadecimal value",
+			`//GetColor : Returns a Color struct from hexadecimal value
+func GetColor(hexValue int) Color {
+	res := C.GetColor(C.int(int32(hexValue)))
+	return newColorFromPointer(unsafe.Pointer(&res))
+}`,
+		},
+		{
+			"RLAPI Rectangle GetCollisionRec(Rectangle rec1, Rectangle rec2); // Get collision rectangle for two rectangles collision",
+			`//GetCollisionRec : Get collision rectangle for two rectangles collision
+func GetCollisionRec(rec1 Rectangle, rec2 Rectangle) Rectangle {
+	crec2 := *rec2.cptr()
+	crec1 := *rec1.cptr()
+	res := C.GetCollisionRec(crec1, crec2)
+	return newRectangleFromPointer(unsafe.Pointer(&res))
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		assertTranslation(t, test.line, false, test.expected)
+	}
+}
+
+func TestConvertArrayArguments(t *testing.T) {
+	assertTranslation(t, "RLAPI void DrawTriangleFan(Vector2 *points, int pointsCount, Color color); // Draw a triangle fan", false, `
+//DrawTriangleFan : Draw a triangle fan
+func DrawTriangleFan(points []Vector2, color Color) {
+	ccolor := *color.cptr()
+	var cpoints *C.Vector2
+	if len(points) > 0 {
+		cpoints = points[0].cptr()
+	}
+	C.DrawTriangleFan(cpoints, C.int(int32(len(points))), ccolor)
+}`)
+}
+
+func TestConvertIntArrayArguments(t *testing.T) {
+	//The int array is copied into C ints, and the count is no longer an argument or a return
+	const expected = `
+//LoadFontEx : Load font from file with extended parameters
+func LoadFontEx(fileName string, fontSize int, fontChars []int) *Font {
+	var cfontChars *C.int
+	if len(fontChars) > 0 {
+		cfontCharsarr := make([]C.int, len(fontChars))
+		for i, v := range fontChars {
+			cfontCharsarr[i] = C.int(v)
+		}
+		cfontChars = &cfontCharsarr[0]
+	}
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+	res := C.LoadFontEx(cfileName, C.int(int32(fontSize)), cfontChars, C.int(int32(len(fontChars))))
+	retval := newFontFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+	return retval
+}`
+	assertTranslation(t, "RLAPI Font LoadFontEx(const char *fileName, int fontSize, int *fontChars, int charsCount);  // Load font from file with extended parameters", true, expected)
+
+	//The bindings must have been regenerated with the same signature
+	generated, err := ioutil.ReadFile("../raylib/text_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	formattedGenerated, err := gofmt.Source(generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formattedExpected, _ := formatSource(expected)
+	if !strings.Contains(string(formattedGenerated), formattedExpected) {
+		t.Errorf("raylib/text_gen.go does not contain the generated LoadFontEx:\n%s", formattedExpected)
+	}
+}
+
+func TestMarkArrayArguments(t *testing.T) {
+	tests := []struct {
+		line    string
+		isArray bool
+	}{
+		{"RLAPI void DrawTriangleFan(Vector2 *points, int pointsCount, Color color);", true},
+		{"RLAPI void DrawLineStrip(Vector2 *points, int count, Color color);", true},
+		{"RLAPI void DrawTriangleFan(Vector2 *points, int numPoints, Color color);", false},
+		{"RLAPI void UnloadFontData(CharInfo chars, int charsCount);", false},
+		{"RLAPI void ImageText(const char *text, int textCount);", false},
+	}
+
+	for _, test := range tests {
+		proto, err := parseLine(test.line)
+		if err != nil {
+			t.Fatalf("parseLine(%q) failed: %v", test.line, err)
+		}
+		if proto.args[0].isArray != test.isArray {
+			t.Errorf("%q: first argument isArray = %v, want %v", test.line, proto.args[0].isArray, test.isArray)
+		}
+		if test.isArray && proto.args[1].countOf != proto.args[0].name {
+			t.Errorf("%q: count is for %q, want %q", test.line, proto.args[1].countOf, proto.args[0].name)
+		}
+	}
+}
+
+func TestConvertVariadic(t *testing.T) {
+	line := "RLAPI void TraceLog(int logType, const char *text, ...);         // Show trace log messages (LOG_DEBUG, LOG_INFO, LOG_WARNING, LOG_ERROR)"
+
+	proto, err := parseLine(line)
+	if err != nil {
+		t.Fatalf("parseLine(%q) failed: %v", line, err)
+	}
+	if !proto.variadic || len(proto.args) != 2 {
+		t.Fatalf("parseLine(%q) variadic = %v with %d arguments, want variadic with 2 arguments", line, proto.variadic, len(proto.args))
+	}
+
+	previousManual := *manualDir
+	defer func() { *manualDir = previousManual }()
+
+	//Without a manual file it is reported as a failure instead of emitting a broken binding
+	*manualDir = t.TempDir() + "/"
+	translation, err := translatePrototype(proto, false)
+	if err == nil || translation != "" {
+		t.Errorf("translatePrototype() = %q, %v, want an error", translation, err)
+	} else if !strings.Contains(err.Error(), "TraceLog.go") {
+		t.Errorf("translatePrototype() error %q does not name the manual file", err)
+	}
+
+	//With a manual file, the manual file is used as is and always ends with a single newline
+	manual := "//TraceLog : Show trace log messages\nfunc TraceLog(logType TraceLogType, text string) {}"
+	for _, contents := range []string{manual, manual + "\n", manual + "\n\n"} {
+		dir := t.TempDir() + "/"
+		if err := ioutil.WriteFile(dir+"TraceLog.go", []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+		*manualDir = dir
+		translation, err = translatePrototype(proto, false)
+		if err != nil || translation != "\n"+manual+"\n" {
+			t.Errorf("translatePrototype() with the manual file %q = %q, %v, want the manual file ending in a newline", contents, translation, err)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+//BeginTextureMode : Initializes render texture for drawing
+// The pixels of the texture cached by ToImage are cleared, as it is being drawn to.
+func BeginTextureMode(target RenderTexture2D) {
+	invalidateTextureImage(target.Texture)
+	ctarget := *target.cptr()
+	C.BeginTextureMode(ctarget)
+}
\ No newline at end of file
@@ -2,6 +2,7 @@
 func (stream *AudioStream) Unload() {
 	cstream := *stream.cptr()
 	C.CloseAudioStream(cstream)
+	UnregisterUnloadable(stream)
 }
 
 //CloseAudioStream : Close audio stream and free memory
@@ -0,0 +1,11 @@
+//DrawLineStrip : Draw lines sequence, connecting each point to the next.
+// At least 2 points are required to draw anything.
+func DrawLineStrip(points []Vector2, color Color) {
+	if len(points) < 2 {
+		return
+	}
+
+	ccolor := *color.cptr()
+	cpoints, count := vector2SliceToC(points)
+	C.DrawLineStrip(cpoints, count, ccolor)
+}
\ No newline at end of file
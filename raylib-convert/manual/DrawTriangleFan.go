@@ -0,0 +1,11 @@
+//DrawTriangleFan : Draw a triangle fan defined by points (first vertex is the center)
+// At least 3 points are required to draw anything.
+func DrawTriangleFan(points []Vector2, color Color) {
+	if len(points) < 3 {
+		return
+	}
+
+	ccolor := *color.cptr()
+	cpoints, count := vector2SliceToC(points)
+	C.DrawTriangleFan(cpoints, count, ccolor)
+}
@@ -0,0 +1,11 @@
+//DrawTriangleStrip : Draw a triangle strip defined by points
+// At least 3 points are required to draw anything.
+func DrawTriangleStrip(points []Vector2, color Color) {
+	if len(points) < 3 {
+		return
+	}
+
+	ccolor := *color.cptr()
+	cpoints, count := vector2SliceToC(points)
+	C.DrawTriangleStrip(cpoints, count, ccolor)
+}
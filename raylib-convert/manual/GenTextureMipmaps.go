@@ -0,0 +1,19 @@
+//GenMipmaps : Generate GPU mipmaps for a texture. The number of mipmaps is updated on the texture.
+func (texture *Texture2D) GenMipmaps() {
+	ctexture := texture.cptr()
+	C.GenTextureMipmaps(ctexture)
+}
+
+//GenTextureMipmaps : Generate GPU mipmaps for a texture
+//
+//Deprecated: The number of mipmaps is only updated on a copy of the texture. Use texture.GenMipmaps() instead.
+func (texture Texture2D) GenTextureMipmaps() {
+	texture.GenMipmaps()
+}
+
+//GenTextureMipmaps : Generate GPU mipmaps for a texture
+//
+//Deprecated: The number of mipmaps is only updated on a copy of the texture. Use texture.GenMipmaps() instead.
+func GenTextureMipmaps(texture Texture2D) {
+	texture.GenMipmaps()
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+//Crop : Crop an image to a defined rectangle. The rectangle is clamped to the bounds of the image, and the image is left unchanged if they do not overlap.
+func (image *Image) Crop(crop Rectangle) {
+	crop, ok := clampImageRect(crop, image.Width, image.Height)
+	if !ok {
+		TraceLog(LogWarning, "[IMAGE] Crop rectangle does not overlap the ", image.Width, "x", image.Height, " image")
+		return
+	}
+
+	ccrop := *crop.cptr()
+	cimage := image.cptr()
+	C.ImageCrop(cimage, ccrop)
+}
+
+//ImageCrop : Crop an image to a defined rectangle
+//Recommended to use image.Crop(crop) instead
+func ImageCrop(image *Image, crop Rectangle) {
+	image.Crop(crop)
+}
\ No newline at end of file
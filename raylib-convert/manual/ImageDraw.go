@@ -0,0 +1,22 @@
+//Draw : Draw a source image within a destination image (tint applied to source). Nothing is drawn if either rectangle or image is empty.
+func (dst *Image) Draw(src *Image, srcRec Rectangle, dstRec Rectangle, tint Color) {
+	if srcRec.Width <= 0 || srcRec.Height <= 0 || dstRec.Width <= 0 || dstRec.Height <= 0 {
+		return
+	}
+	if src.Width <= 0 || src.Height <= 0 || dst.Width <= 0 || dst.Height <= 0 {
+		return
+	}
+
+	ctint := *tint.cptr()
+	cdstRec := *dstRec.cptr()
+	csrcRec := *srcRec.cptr()
+	csrc := *src.cptr()
+	cdst := dst.cptr()
+	C.ImageDraw(cdst, csrc, csrcRec, cdstRec, ctint)
+}
+
+//ImageDraw : Draw a source image within a destination image (tint applied to source)
+//Recommended to use dst.Draw(src, srcRec, dstRec, tint) instead
+func ImageDraw(dst *Image, src *Image, srcRec Rectangle, dstRec Rectangle, tint Color) {
+	dst.Draw(src, srcRec, dstRec, tint)
+}
\ No newline at end of file
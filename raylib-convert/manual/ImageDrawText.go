@@ -0,0 +1,19 @@
+//DrawText : Draw text (default font) within an image (destination). Nothing is drawn if the text is empty or the font size is not positive.
+func (dst *Image) DrawText(position Vector2, text string, fontSize int, color Color) {
+	if text == "" || fontSize <= 0 {
+		return
+	}
+
+	ccolor := *color.cptr()
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	cposition := *position.cptr()
+	cdst := dst.cptr()
+	C.ImageDrawText(cdst, cposition, ctext, C.int(int32(fontSize)), ccolor)
+}
+
+//ImageDrawText : Draw text (default font) within an image (destination)
+//Recommended to use dst.DrawText(position, text, fontSize, color) instead
+func ImageDrawText(dst *Image, position Vector2, text string, fontSize int, color Color) {
+	dst.DrawText(position, text, fontSize, color)
+}
\ No newline at end of file
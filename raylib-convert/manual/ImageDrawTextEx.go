@@ -0,0 +1,20 @@
+//DrawTextEx : Draw text (custom sprite font) within an image (destination). Nothing is drawn if the text is empty or the font size is not positive.
+func (dst *Image) DrawTextEx(position Vector2, font *Font, text string, fontSize float32, spacing float32, color Color) {
+	if text == "" || fontSize <= 0 {
+		return
+	}
+
+	ccolor := *color.cptr()
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	cfont := *font.cptr()
+	cposition := *position.cptr()
+	cdst := dst.cptr()
+	C.ImageDrawTextEx(cdst, cposition, cfont, ctext, C.float(fontSize), C.float(spacing), ccolor)
+}
+
+//ImageDrawTextEx : Draw text (custom sprite font) within an image (destination)
+//Recommended to use dst.DrawTextEx(position, font, text, fontSize, spacing, color) instead
+func ImageDrawTextEx(dst *Image, position Vector2, font *Font, text string, fontSize float32, spacing float32, color Color) {
+	dst.DrawTextEx(position, font, text, fontSize, spacing, color)
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+//Resize : Resize image (Bicubic scaling algorithm). The image is left unchanged if the new size is not positive.
+func (image *Image) Resize(newWidth int, newHeight int) {
+	if newWidth <= 0 || newHeight <= 0 {
+		TraceLog(LogWarning, "[IMAGE] Cannot resize the image to ", newWidth, "x", newHeight)
+		return
+	}
+
+	cimage := image.cptr()
+	C.ImageResize(cimage, C.int(int32(newWidth)), C.int(int32(newHeight)))
+}
+
+//ImageResize : Resize image (Bicubic scaling algorithm)
+//Recommended to use image.Resize(newWidth, newHeight) instead
+func ImageResize(image *Image, newWidth int, newHeight int) {
+	image.Resize(newWidth, newHeight)
+}
\ No newline at end of file
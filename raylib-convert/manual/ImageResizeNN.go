@@ -0,0 +1,16 @@
+//ResizeNN : Resize image (Nearest-Neighbor scaling algorithm). The image is left unchanged if the new size is not positive.
+func (image *Image) ResizeNN(newWidth int, newHeight int) {
+	if newWidth <= 0 || newHeight <= 0 {
+		TraceLog(LogWarning, "[IMAGE] Cannot resize the image to ", newWidth, "x", newHeight)
+		return
+	}
+
+	cimage := image.cptr()
+	C.ImageResizeNN(cimage, C.int(int32(newWidth)), C.int(int32(newHeight)))
+}
+
+//ImageResizeNN : Resize image (Nearest-Neighbor scaling algorithm)
+//Recommended to use image.ResizeNN(newWidth, newHeight) instead
+func ImageResizeNN(image *Image, newWidth int, newHeight int) {
+	image.ResizeNN(newWidth, newHeight)
+}
\ No newline at end of file
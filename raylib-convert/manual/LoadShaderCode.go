@@ -0,0 +1,17 @@
+//LoadShaderCode : Load shader from code strings and bind default locations.
+// An empty string uses the default shader for that stage.
+func LoadShaderCode(vsCode string, fsCode string) Shader {
+	var cvsCode, cfsCode *C.char
+	if vsCode != "" {
+		cvsCode = C.CString(vsCode)
+		defer C.free(unsafe.Pointer(cvsCode))
+	}
+	if fsCode != "" {
+		cfsCode = C.CString(fsCode)
+		defer C.free(unsafe.Pointer(cfsCode))
+	}
+	res := C.LoadShaderCode(cvsCode, cfsCode)
+	retval := newShaderFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+	return retval
+}
\ No newline at end of file
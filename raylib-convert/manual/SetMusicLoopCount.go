@@ -0,0 +1,15 @@
+//SetLoopCount : Set music loop count (loop repeats). A count of 0 loops forever.
+func (music *Music) SetLoopCount(count int) {
+	if count < 0 {
+		count = 0
+	}
+
+	//raylib sets the count on a copy of the music, so it must be set here instead
+	music.LoopCount = uint32(count)
+}
+
+//SetMusicLoopCount : Set music loop count (loop repeats)
+//Recommended to use music.SetLoopCount(count) instead
+func SetMusicLoopCount(music *Music, count int) {
+	music.SetLoopCount(count)
+}
\ No newline at end of file
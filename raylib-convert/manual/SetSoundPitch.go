@@ -0,0 +1,15 @@
+//SetPitch : Set pitch for a sound (1.0 is base level). The pitch is at least MinSoundPitch.
+func (sound *Sound) SetPitch(pitch float32) {
+	if pitch < MinSoundPitch {
+		pitch = MinSoundPitch
+	}
+
+	csound := *sound.cptr()
+	C.SetSoundPitch(csound, C.float(pitch))
+}
+
+//SetSoundPitch : Set pitch for a sound (1.0 is base level)
+//Recommended to use sound.SetPitch(pitch) instead
+func SetSoundPitch(sound *Sound, pitch float32) {
+	sound.SetPitch(pitch)
+}
\ No newline at end of file
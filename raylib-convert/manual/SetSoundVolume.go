@@ -0,0 +1,11 @@
+//SetVolume : Set volume for a sound (1.0 is max level). The volume is clamped between 0 and 1.
+func (sound *Sound) SetVolume(volume float32) {
+	csound := *sound.cptr()
+	C.SetSoundVolume(csound, C.float(Clamp32(volume, 0, 1)))
+}
+
+//SetSoundVolume : Set volume for a sound (1.0 is max level)
+//Recommended to use sound.SetVolume(volume) instead
+func SetSoundVolume(sound *Sound, volume float32) {
+	sound.SetVolume(volume)
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+//SetFilter : Set texture scaling filter mode
+func (texture *Texture2D) SetFilter(filterMode TextureFilterMode) {
+	ctexture := *texture.cptr()
+	C.SetTextureFilter(ctexture, C.int(int32(filterMode)))
+}
+
+//SetTextureFilter : Set texture scaling filter mode
+//
+//Deprecated: Use texture.SetFilter(filterMode) instead.
+func (texture Texture2D) SetTextureFilter(filterMode TextureFilterMode) {
+	texture.SetFilter(filterMode)
+}
+
+//SetTextureFilter : Set texture scaling filter mode
+//Recommended to use texture.SetFilter(filterMode) instead
+func SetTextureFilter(texture Texture2D, filterMode TextureFilterMode) {
+	texture.SetFilter(filterMode)
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+//Unload : Unload shader from GPU memory (VRAM)
+func (shader Shader) Unload() {
+	clearShaderUniforms(shader)
+	cshader := *shader.cptr()
+	C.UnloadShader(cshader)
+	UnregisterUnloadable(shader)
+}
+
+//UnloadShader : Unload shader from GPU memory (VRAM)
+//Recommended to use shader.Unload() instead
+func UnloadShader(shader Shader) {
+	shader.Unload()
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+//Unload : Unload texture from GPU memory (VRAM)
+func (texture Texture2D) Unload() {
+	invalidateTextureImage(texture)
+	ctexture := *texture.cptr()
+	C.UnloadTexture(ctexture)
+	UnregisterUnloadable(texture)
+}
+
+//UnloadTexture : Unload texture from GPU memory (VRAM)
+//Recommended to use texture.Unload() instead
+func UnloadTexture(texture Texture2D) {
+	texture.Unload()
+}
@@ -1,5 +1,16 @@
-//Update : Update audio stream buffers with data
+//Update : Update audio stream buffers with data. The samples count is the number of samples for all channels,
+// and is limited to the size of the data. Nothing is updated if there are no samples. See UpdateInt16 and UpdateFloat32.
 func (stream *AudioStream) Update(data []float32, samplesCount int) {
+	if stream.SampleSize > 0 {
+		available := len(data) * 32 / int(stream.SampleSize)
+		if samplesCount > available {
+			samplesCount = available
+		}
+	}
+	if samplesCount <= 0 || len(data) == 0 {
+		return
+	}
+
 	cstream := *stream.cptr()
 	C.UpdateAudioStream(cstream, unsafe.Pointer(&data[0]), C.int(int32(samplesCount)))
 }
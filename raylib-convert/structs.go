@@ -0,0 +1,174 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+//structDefinition is a C struct parsed from a typedef
+type structDefinition struct {
+	name    string
+	comment string
+	fields  []structField
+}
+
+type structField struct {
+	name    string
+	goType  string
+	comment string
+}
+
+var reStructStart = regexp.MustCompile(`^typedef struct\s*(\w*)\s*\{`)
+var reStructEnd = regexp.MustCompile(`^\}\s*(\w+)\s*;`)
+var reStructField = regexp.MustCompile(`^(const |unsigned )?(\w+)\s+([^;]+);\s*(//\s*(.*))?$`)
+var reStructFieldName = regexp.MustCompile(`^(\**)\s*(\w+)\s*(\[(\w+)\])?$`)
+
+//isStructStart checks if the line begins a struct typedef that has a body
+func isStructStart(line string) bool {
+	return reStructStart.MatchString(line)
+}
+
+//isStructEnd checks if the line closes a struct typedef
+func isStructEnd(line string) bool {
+	return reStructEnd.MatchString(line)
+}
+
+//parseStruct parses the lines of a typedef struct, from the opening typedef to the closing name
+func parseStruct(lines []string, comment string) (*structDefinition, error) {
+	if len(lines) < 2 {
+		return nil, errors.New("struct is missing its body")
+	}
+
+	end := reStructEnd.FindStringSubmatch(strings.TrimSpace(lines[len(lines)-1]))
+	if end == nil {
+		return nil, errors.New("struct is missing its closing name")
+	}
+
+	def := &structDefinition{
+		name:    end[1],
+		comment: strings.Trim(comment, " /"),
+		fields:  make([]structField, 0),
+	}
+
+	for _, line := range lines[1 : len(lines)-1] {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		matches := reStructField.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, errors.New("cannot process struct field: " + line)
+		}
+
+		unsigned := strings.TrimSpace(matches[1]) == "unsigned"
+		for _, declaration := range strings.Split(matches[3], ",") {
+			name := reStructFieldName.FindStringSubmatch(strings.TrimSpace(declaration))
+			if name == nil {
+				return nil, errors.New("cannot process struct field name: " + declaration)
+			}
+
+			goType, err := convertFieldType(matches[2], unsigned, len(name[1]), name[4])
+			if err != nil {
+				return nil, err
+			}
+
+			def.fields = append(def.fields, structField{
+				name:    exportName(name[2]),
+				goType:  goType,
+				comment: strings.TrimSpace(matches[5]),
+			})
+		}
+	}
+
+	return def, nil
+}
+
+//convertFieldType converts the C type of a field into a Go type with the same memory layout
+func convertFieldType(t string, unsigned bool, pointerDepth int, arrayLength string) (string, error) {
+	if pointerDepth > 1 {
+		return "", errors.New("cannot process pointer of pointer fields")
+	}
+
+	goType := t
+	switch t {
+	case "float":
+		goType = "float32"
+	case "double":
+		goType = "float64"
+	case "int":
+		goType = "int32"
+	case "short":
+		goType = "int16"
+	case "char":
+		goType = "int8"
+	case "bool":
+		goType = "bool"
+	case "void":
+		if pointerDepth != 1 {
+			return "", errors.New("cannot process void fields")
+		}
+		goType = "unsafe.Pointer"
+		pointerDepth = 0
+	}
+
+	if unsigned {
+		switch t {
+		case "int":
+			goType = "uint32"
+		case "short":
+			goType = "uint16"
+		case "char":
+			goType = "uint8"
+		default:
+			return "", errors.New("cannot process unsigned " + t + " fields")
+		}
+	}
+
+	if pointerDepth == 1 {
+		goType = "*" + goType
+	}
+	if arrayLength != "" {
+		goType = "[" + arrayLength + "]" + goType
+	}
+	return goType, nil
+}
+
+//exportName capitalises the first letter of a field so it is exported
+func exportName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+//translateStruct creates the Go struct and its cptr and new<Type>FromPointer helpers
+func translateStruct(def *structDefinition) string {
+	var sb strings.Builder
+
+	comment := def.comment
+	if comment == "" {
+		comment = def.name + " struct"
+	}
+	fmt.Fprintf(&sb, "//%s : %s\n", def.name, comment)
+	fmt.Fprintf(&sb, "type %s struct {\n", def.name)
+	for _, field := range def.fields {
+		if field.comment != "" {
+			fmt.Fprintf(&sb, "//%s\n", field.comment)
+		}
+		fmt.Fprintf(&sb, "%s %s\n", field.name, field.goType)
+	}
+	sb.WriteString("}\n\n")
+
+	//Follow the wrapper map so the helper matches how the type is returned by functions
+	if wrapperTypes[def.name] {
+		fmt.Fprintf(&sb, "func new%sFromPointer(ptr unsafe.Pointer) *%s { return (*%s)(ptr) }\n", def.name, def.name, def.name)
+	} else {
+		fmt.Fprintf(&sb, "func new%sFromPointer(ptr unsafe.Pointer) %s { return *(*%s)(ptr) }\n", def.name, def.name, def.name)
+	}
+	fmt.Fprintf(&sb, "func (s *%s) cptr() *C.%s { return (*C.%s)(unsafe.Pointer(s)) }\n", def.name, def.name, def.name)
+
+	return sb.String()
+}
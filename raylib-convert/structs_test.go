@@ -0,0 +1,95 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseStruct(t *testing.T) {
+	lines := []string{
+		"typedef struct Image {",
+		"    void *data;             // Image raw data",
+		"    int width, height;      // Image base width and height",
+		"    unsigned char mask[4];  // Channel mask",
+		"    float *weights;",
+		"} Image;",
+	}
+
+	def, err := parseStruct(lines, "// Image type, bpp always RGBA (32bit)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if def.name != "Image" || def.comment != "Image type, bpp always RGBA (32bit)" {
+		t.Errorf("parseStruct() name = %q comment = %q", def.name, def.comment)
+	}
+
+	expected := []structField{
+		{name: "Data", goType: "unsafe.Pointer", comment: "Image raw data"},
+		{name: "Width", goType: "int32", comment: "Image base width and height"},
+		{name: "Height", goType: "int32", comment: "Image base width and height"},
+		{name: "Mask", goType: "[4]uint8", comment: "Channel mask"},
+		{name: "Weights", goType: "*float32"},
+	}
+	if !reflect.DeepEqual(def.fields, expected) {
+		t.Errorf("parseStruct() fields =\n%+v\nwant\n%+v", def.fields, expected)
+	}
+}
+
+func TestParseStructErrors(t *testing.T) {
+	tests := [][]string{
+		{"typedef struct Broken {"},
+		{"typedef struct Broken {", "    int value;", "}"},
+		{"typedef struct Broken {", "    int **values;", "} Broken;"},
+		{"typedef struct Broken {", "    unsigned float value;", "} Broken;"},
+	}
+
+	for _, lines := range tests {
+		if _, err := parseStruct(lines, ""); err == nil {
+			t.Errorf("parseStruct(%q) succeeded, want an error", lines)
+		}
+	}
+}
+
+func TestTranslateStruct(t *testing.T) {
+	lines := []string{
+		"typedef struct Vector2 {",
+		"    float x;",
+		"    float y;",
+		"} Vector2;",
+	}
+	def, err := parseStruct(lines, "// Vector2 type")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := formatSource(translateStruct(def))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := formatSource(`//Vector2 : Vector2 type
+type Vector2 struct {
+	X float32
+	Y float32
+}
+
+func newVector2FromPointer(ptr unsafe.Pointer) Vector2 { return *(*Vector2)(ptr) }
+func (s *Vector2) cptr() *C.Vector2 { return (*C.Vector2)(unsafe.Pointer(s)) }`)
+	if actual != expected {
+		t.Errorf("translateStruct() =\n%s\nwant\n%s", actual, expected)
+	}
+}
+
+func TestTranslateStructPointerWrapper(t *testing.T) {
+	//Types in the wrapper map that are returned by pointer get a pointer helper
+	def := &structDefinition{name: "Font", fields: []structField{{name: "BaseSize", goType: "int32"}}}
+	translation := translateStruct(def)
+
+	if !strings.Contains(translation, "func newFontFromPointer(ptr unsafe.Pointer) *Font { return (*Font)(ptr) }") {
+		t.Errorf("translateStruct() is missing the pointer helper:\n%s", translation)
+	}
+	if !strings.Contains(translation, "//Font : Font struct") {
+		t.Errorf("translateStruct() is missing the default comment:\n%s", translation)
+	}
+}
@@ -1,7 +1,14 @@
 package rgif
 
 import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
 	"image/gif"
+	"io"
 	"os"
 
 	r "github.com/lachee/raylib-goplus/raylib"
@@ -16,6 +23,9 @@ const (
 	FrameDisposalRestorePrevious
 )
 
+//ErrNoFrames is returned when loading a gif that does not contain any frames
+var ErrNoFrames = errors.New("gif does not contain any frames")
+
 //GifImage represents a gif texture
 type GifImage struct {
 
@@ -31,10 +41,17 @@ type GifImage struct {
 	Timing []int
 	//Disposal is the disposal for each frame
 	Disposal []FrameDisposal
+	//LoopCount is how many times the gif plays before stopping on the last frame. 0 loops forever.
+	LoopCount int
+	//OnComplete is called every time the gif loops back to the first frame
+	OnComplete func()
 
 	pixels        [][]r.Color //Cache of each frame's pixels
 	currentFrame  int         //The current frame
 	lastFrameTime float32     //Update since last frame
+	paused        bool        //Is the playback paused
+	loops         int         //Number of times the gif has looped
+	speed         float32     //Multiplier of the playback speed
 }
 
 //LoadGifFromFile loads a new gif
@@ -42,10 +59,21 @@ func LoadGifFromFile(fileName string) (*GifImage, error) {
 
 	//Read the GIF file
 	file, err := os.Open(fileName)
-	defer file.Close()
 	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
+
+	return LoadGifFromReader(file)
+}
+
+//LoadGifFromReader loads a new gif from a reader
+func LoadGifFromReader(reader io.Reader) (*GifImage, error) {
+	return loadGif(reader, r.LoadTextureFromGo)
+}
+
+//loadGif decodes the gif from the reader, creating the texture of the first frame with loadTexture
+func loadGif(reader io.Reader, loadTexture func(image.Image) r.Texture2D) (*GifImage, error) {
 
 	/*//Defer any panics
 	defer func() {
@@ -55,11 +83,16 @@ func LoadGifFromFile(fileName string) (*GifImage, error) {
 	}()*/
 
 	//Decode teh gif
-	gif, err := gif.DecodeAll(file)
+	gif, err := gif.DecodeAll(reader)
 	if err != nil {
 		return nil, err
 	}
 
+	//Make sure we actually have something to show
+	if len(gif.Image) == 0 {
+		return nil, ErrNoFrames
+	}
+
 	//Prepare the tilesheet and overpaint image.
 	imgWidth, imgHeight := getGifDimensions(gif)
 	frames := len(gif.Image)
@@ -120,46 +153,272 @@ func LoadGifFromFile(fileName string) (*GifImage, error) {
 		images[i] = pixels
 	}
 
+	//The gif's loop count is the number of repeats, while ours is the number of plays
+	loopCount := 0
+	switch {
+	case gif.LoopCount == -1:
+		loopCount = 1
+	case gif.LoopCount > 0:
+		loopCount = gif.LoopCount + 1
+	}
+
 	//Load the first initial texture
-	texture := r.LoadTextureFromGo(gif.Image[0])
+	texture := loadTexture(gif.Image[0])
 
 	return &GifImage{
-		Texture:  texture,
-		pixels:   images,
-		Width:    imgWidth,
-		Height:   imgHeight,
-		Frames:   frames,
-		Timing:   gif.Delay,
-		Disposal: disposals,
+		Texture:   texture,
+		pixels:    images,
+		Width:     imgWidth,
+		Height:    imgHeight,
+		Frames:    frames,
+		Timing:    gif.Delay,
+		Disposal:  disposals,
+		LoopCount: loopCount,
+		speed:     1,
 	}, nil
 }
 
-//Step performs a time step.
+//Step performs a time step, scaled by the playback speed.
+// Gifs with one or no frames do not animate, and paused gifs do not advance, so this does nothing.
 func (gif *GifImage) Step(timeSinceLastStep float32) {
+	gif.step(timeSinceLastStep, r.UpdateTexture)
+}
+
+//step performs a time step, uploading the frame it moves to with updateTexture
+func (gif *GifImage) step(timeSinceLastStep float32, updateTexture func(*r.Texture2D, []r.Color)) {
+	if gif.Frames <= 1 || gif.paused {
+		return
+	}
 
-	gif.lastFrameTime += (timeSinceLastStep * 100)
+	gif.lastFrameTime += (timeSinceLastStep * gif.speed * 100)
 	diff := gif.lastFrameTime - float32(gif.Timing[gif.currentFrame])
 
 	if diff >= 0 {
-		gif.NextFrame()
+		gif.nextFrame(updateTexture)
 	}
 }
 
 //NextFrame increments the frame counter and resets the timing buffer
+// Gifs with one or no frames do not animate, and completed gifs stay on their last frame, so this does nothing.
 func (gif *GifImage) NextFrame() {
+	gif.nextFrame(r.UpdateTexture)
+}
+
+//nextFrame increments the frame counter, uploading the new frame with updateTexture
+func (gif *GifImage) nextFrame(updateTexture func(*r.Texture2D, []r.Color)) {
+	if gif.Frames <= 1 || gif.IsComplete() {
+		return
+	}
+
 	gif.lastFrameTime -= float32(gif.Timing[gif.currentFrame])
-	gif.currentFrame = (gif.currentFrame + 1) % gif.Frames
 	if gif.lastFrameTime < 0 {
 		gif.lastFrameTime = 0
 	}
 
-	gif.Texture.UpdateTexture(gif.pixels[gif.currentFrame])
+	//Wrapping around to the start completes a loop
+	if gif.currentFrame+1 >= gif.Frames {
+		gif.loops++
+		if gif.OnComplete != nil {
+			gif.OnComplete()
+		}
+
+		if gif.IsComplete() {
+			gif.lastFrameTime = 0
+			return
+		}
+	}
+
+	gif.currentFrame = (gif.currentFrame + 1) % gif.Frames
+
+	updateTexture(&gif.Texture, gif.pixels[gif.currentFrame])
 }
 
-//Reset clears the last frame time and resets the current frame to zero
+//Reset clears the last frame time, the number of loops played and resets the current frame to zero
 func (gif *GifImage) Reset() {
 	gif.currentFrame = 0
 	gif.lastFrameTime = 0
+	gif.loops = 0
+}
+
+//IsComplete returns true once the gif has played LoopCount times. Gifs that loop forever are never complete.
+func (gif *GifImage) IsComplete() bool {
+	return gif.LoopCount > 0 && gif.loops >= gif.LoopCount
+}
+
+//Loops is the number of times the gif has played through
+func (gif *GifImage) Loops() int { return gif.loops }
+
+//Pause stops the gif from advancing when stepped
+func (gif *GifImage) Pause() { gif.paused = true }
+
+//Resume continues playing a paused gif from where it was paused
+func (gif *GifImage) Resume() { gif.paused = false }
+
+//IsPaused returns true if the gif is paused
+func (gif *GifImage) IsPaused() bool { return gif.paused }
+
+//SetSpeed sets the playback speed multiplier, where 0.5 is half speed and 2 is double speed. Negative speeds are treated as 0.
+func (gif *GifImage) SetSpeed(multiplier float32) {
+	if multiplier < 0 {
+		multiplier = 0
+	}
+	gif.speed = multiplier
+}
+
+//Speed gets the playback speed multiplier
+func (gif *GifImage) Speed() float32 { return gif.speed }
+
+//Recolor swaps colors in every frame of the gif, such as for palette swaps or damage flashes.
+// Every pixel that matches a key in the mapping is replaced with its value, then the current frame is re-uploaded.
+func (gif *GifImage) Recolor(mapping map[r.Color]r.Color) {
+	gif.recolor(mapping, r.UpdateTexture)
+}
+
+//recolor swaps the colours in every frame, uploading the current frame again with updateTexture
+func (gif *GifImage) recolor(mapping map[r.Color]r.Color, updateTexture func(*r.Texture2D, []r.Color)) {
+	if len(mapping) == 0 || len(gif.pixels) == 0 {
+		return
+	}
+
+	for _, pixels := range gif.pixels {
+		for i, color := range pixels {
+			if replace, ok := mapping[color]; ok {
+				pixels[i] = replace
+			}
+		}
+	}
+
+	updateTexture(&gif.Texture, gif.pixels[gif.currentFrame])
+}
+
+//ToSpriteAnimation creates a sprite animation from the frames of the gif, using the gif's timing for each frame.
+// The frames are packed into a new horizontal sheet texture that matches GetRectangle, which must be unloaded separately.
+// Frames with no delay are shown for a tenth of a second, the same as most browsers.
+func (gif *GifImage) ToSpriteAnimation() *r.SpriteAnimation {
+	return gif.toSpriteAnimation(r.LoadTextureFromImage)
+}
+
+//toSpriteAnimation creates the sprite animation, creating the sheet texture with loadTextureFromImage
+func (gif *GifImage) toSpriteAnimation(loadTextureFromImage func(*r.Image) r.Texture2D) *r.SpriteAnimation {
+	sheetWidth := gif.Width * gif.Frames
+	sheet := make([]r.Color, sheetWidth*gif.Height)
+	for frame, pixels := range gif.pixels {
+		for y := 0; y < gif.Height; y++ {
+			copy(sheet[frame*gif.Width+y*sheetWidth:], pixels[y*gif.Width:(y+1)*gif.Width])
+		}
+	}
+
+	image := r.LoadImageEx(sheet, int32(sheetWidth), int32(gif.Height))
+	texture := loadTextureFromImage(image)
+	image.Unload()
+
+	frames := make([]r.Rectangle, gif.Frames)
+	for i := range frames {
+		frames[i] = gif.GetRectangle(i)
+	}
+
+	anim := r.NewSpriteAnimation(texture, frames, 0.1)
+	for i := range anim.Durations {
+		if i < len(gif.Timing) && gif.Timing[i] > 0 {
+			anim.Durations[i] = float32(gif.Timing[i]) / 100
+		}
+	}
+
+	return anim
+}
+
+//SaveToFile encodes the frames of the gif and writes them to a file, keeping the timing, disposal and loop count.
+// Frames are saved fully composited. Frames with more than 255 colours are dithered to a standard palette.
+func (gif *GifImage) SaveToFile(fileName string) error {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+
+	if err := gif.SaveToWriter(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+//SaveToWriter encodes the frames of the gif to a writer. See SaveToFile.
+func (gif *GifImage) SaveToWriter(writer io.Writer) error {
+	if len(gif.pixels) == 0 {
+		return ErrNoFrames
+	}
+
+	return encodeGif(writer, gif)
+}
+
+//encodeGif encodes the gif. This is separate so the gif package is not shadowed by the receiver.
+func encodeGif(writer io.Writer, source *GifImage) error {
+	output := &gif.GIF{
+		Image:    make([]*image.Paletted, len(source.pixels)),
+		Delay:    make([]int, len(source.pixels)),
+		Disposal: make([]byte, len(source.pixels)),
+		Config:   image.Config{Width: source.Width, Height: source.Height},
+	}
+
+	//Our loop count is the number of plays, while the gif's is the number of repeats
+	switch {
+	case source.LoopCount == 0:
+		output.LoopCount = 0
+	case source.LoopCount == 1:
+		output.LoopCount = -1
+	default:
+		output.LoopCount = source.LoopCount - 1
+	}
+
+	for i, pixels := range source.pixels {
+		output.Image[i] = palettedFrame(pixels, source.Width, source.Height)
+		if i < len(source.Timing) {
+			output.Delay[i] = source.Timing[i]
+		}
+		if i < len(source.Disposal) {
+			output.Disposal[i] = byte(source.Disposal[i])
+		}
+	}
+
+	return gif.EncodeAll(writer, output)
+}
+
+//palettedFrame converts the pixels of a frame to a paletted image, using the exact colours when there are few enough
+func palettedFrame(pixels []r.Color, width, height int) *image.Paletted {
+	bounds := image.Rect(0, 0, width, height)
+	source := image.NewNRGBA(bounds)
+	for i, c := range pixels {
+		source.Pix[i*4+0] = c.R
+		source.Pix[i*4+1] = c.G
+		source.Pix[i*4+2] = c.B
+		source.Pix[i*4+3] = c.A
+	}
+
+	//Transparent is always the first colour, leaving room for 255 others
+	colors := color.Palette{color.NRGBA{}}
+	indices := map[r.Color]uint8{}
+	for _, c := range pixels {
+		if c.A == 0 {
+			continue
+		}
+		if _, ok := indices[c]; !ok {
+			if len(colors) >= 256 {
+				frame := image.NewPaletted(bounds, append(color.Palette{color.NRGBA{}}, palette.Plan9[:255]...))
+				draw.FloydSteinberg.Draw(frame, bounds, source, image.Point{})
+				return frame
+			}
+			indices[c] = uint8(len(colors))
+			colors = append(colors, color.NRGBA{R: c.R, G: c.G, B: c.B, A: 255})
+		}
+	}
+
+	frame := image.NewPaletted(bounds, colors)
+	for i, c := range pixels {
+		if c.A > 0 {
+			frame.Pix[i] = indices[c]
+		}
+	}
+	return frame
 }
 
 //Unload unloads all the textures and images, making this gif unusable.
@@ -171,7 +430,98 @@ func (gif *GifImage) Unload() {
 func (gif *GifImage) CurrentFrame() int { return gif.currentFrame }
 
 //CurrentTiming gets the current timing for the current frame
-func (gif *GifImage) CurrentTiming() int { return gif.Timing[gif.currentFrame] }
+func (gif *GifImage) CurrentTiming() int {
+	if gif.currentFrame >= len(gif.Timing) {
+		return 0
+	}
+	return gif.Timing[gif.currentFrame]
+}
+
+//GetPixel gets the colour of a pixel in a frame, such as for hit-testing against an animated sprite.
+// The colour is of the fully composited frame, as it appears when drawn, rather than the raw frame data.
+func (gif *GifImage) GetPixel(frame, x, y int) (r.Color, error) {
+	if frame < 0 || frame >= len(gif.pixels) {
+		return r.Color{}, fmt.Errorf("frame %d is out of range, the gif has %d frames", frame, len(gif.pixels))
+	}
+	if x < 0 || y < 0 || x >= gif.Width || y >= gif.Height {
+		return r.Color{}, fmt.Errorf("pixel %d, %d is outside of the %dx%d gif", x, y, gif.Width, gif.Height)
+	}
+	return gif.pixels[frame][x+y*gif.Width], nil
+}
+
+//OnionSkin composites a frame over faded copies of its neighbours, for previewing motion in animation editors.
+// Up to before frames leading up to it and after frames following it are drawn, with further frames more transparent.
+// The neighbours are clamped to the ends of the gif rather than wrapping around. The image must be unloaded.
+func (gif *GifImage) OnionSkin(frame, before, after int) *r.Image {
+	if frame < 0 {
+		frame = 0
+	}
+	if frame >= len(gif.pixels) {
+		frame = len(gif.pixels) - 1
+	}
+
+	pixels := make([]r.Color, gif.Width*gif.Height)
+	if frame >= 0 {
+		first, last := frame-before, frame+after
+		if first < 0 {
+			first = 0
+		}
+		if last >= len(gif.pixels) {
+			last = len(gif.pixels) - 1
+		}
+
+		furthest, count := frame-first, before
+		if last-frame > furthest {
+			furthest = last - frame
+		}
+		if after > count {
+			count = after
+		}
+
+		//Furthest frames are drawn first so the nearest ones are on top
+		for distance := furthest; distance > 0; distance-- {
+			opacity := onionSkinOpacity(distance, count)
+			if frame-distance >= first {
+				compositeOver(pixels, gif.pixels[frame-distance], opacity)
+			}
+			if frame+distance <= last {
+				compositeOver(pixels, gif.pixels[frame+distance], opacity)
+			}
+		}
+
+		compositeOver(pixels, gif.pixels[frame], 1)
+	}
+
+	return r.LoadImageEx(pixels, int32(gif.Width), int32(gif.Height))
+}
+
+//onionSkinOpacity is the opacity of a neighbour, fading from half opacity for the nearest to nearly transparent for the furthest
+func onionSkinOpacity(distance, count int) float32 {
+	return 0.5 * float32(count-distance+1) / float32(count)
+}
+
+//compositeOver draws the source pixels over the destination at the given opacity
+func compositeOver(dst, src []r.Color, opacity float32) {
+	for i := range dst {
+		if i >= len(src) {
+			return
+		}
+
+		srcAlpha := float32(src[i].A) / 255 * opacity
+		dstAlpha := float32(dst[i].A) / 255
+		outAlpha := srcAlpha + dstAlpha*(1-srcAlpha)
+		if outAlpha <= 0 {
+			dst[i] = r.Color{}
+			continue
+		}
+
+		channel := func(s, d uint8) uint8 {
+			value := (float32(s)*srcAlpha + float32(d)*dstAlpha*(1-srcAlpha)) / outAlpha
+			return uint8(value + 0.5)
+		}
+		dst[i] = r.NewColor(channel(src[i].R, dst[i].R), channel(src[i].G, dst[i].G), channel(src[i].B, dst[i].B), uint8(outAlpha*255+0.5))
+	}
+}
 
 //GetRectangle gets a rectangle crop for a specified frame
 func (gif *GifImage) GetRectangle(frame int) r.Rectangle {
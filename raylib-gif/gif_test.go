@@ -0,0 +1,492 @@
+package rgif
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+
+	r "github.com/lachee/raylib-goplus/raylib"
+)
+
+var (
+	testRed   = color.RGBA{R: 255, A: 255}
+	testGreen = color.RGBA{G: 255, A: 255}
+	testBlue  = color.RGBA{B: 255, A: 255}
+)
+
+//fakeTextures stands in for the texture functions, recording each frame uploaded instead of using OpenGL
+type fakeTextures struct {
+	loads   int
+	uploads [][]r.Color
+	images  [][]r.Color
+}
+
+func (fake *fakeTextures) loadTexture(img image.Image) r.Texture2D {
+	fake.loads++
+	bounds := img.Bounds()
+	return r.Texture2D{Id: 1, Width: int32(bounds.Dx()), Height: int32(bounds.Dy())}
+}
+
+func (fake *fakeTextures) loadTextureFromImage(img *r.Image) r.Texture2D {
+	fake.loads++
+	fake.images = append(fake.images, img.GetPixels())
+	return r.Texture2D{Id: 1, Width: img.Width, Height: img.Height}
+}
+
+func (fake *fakeTextures) updateTexture(texture *r.Texture2D, pixels []r.Color) {
+	fake.uploads = append(fake.uploads, append([]r.Color(nil), pixels...))
+}
+
+//solidFrame creates a frame filled with a single colour
+func solidFrame(width, height int, c color.Color) *image.Paletted {
+	frame := image.NewPaletted(image.Rect(0, 0, width, height), color.Palette{color.Transparent, c})
+	for i := range frame.Pix {
+		frame.Pix[i] = 1
+	}
+	return frame
+}
+
+//encodeTestGif encodes the frames into an in-memory gif
+func encodeTestGif(t *testing.T, frames []*image.Paletted, delays []int, disposal []byte, loopCount int) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	err := gif.EncodeAll(&buffer, &gif.GIF{Image: frames, Delay: delays, Disposal: disposal, LoopCount: loopCount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+//loadTestGif encodes and loads a gif with solid frames of the colours, each shown for the delay
+func loadTestGif(t *testing.T, fake *fakeTextures, delay int, colors ...color.Color) *GifImage {
+	t.Helper()
+	frames := make([]*image.Paletted, len(colors))
+	delays := make([]int, len(colors))
+	for i, c := range colors {
+		frames[i] = solidFrame(4, 2, c)
+		delays[i] = delay
+	}
+
+	loaded, err := loadGif(bytes.NewReader(encodeTestGif(t, frames, delays, nil, 0)), fake.loadTexture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loaded
+}
+
+func TestLoadGifSingleFrame(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed)
+
+	if loaded.Frames != 1 || loaded.Width != 4 || loaded.Height != 2 {
+		t.Fatalf("loaded %d frames of %dx%d, want 1 frame of 4x2", loaded.Frames, loaded.Width, loaded.Height)
+	}
+	if fake.loads != 1 {
+		t.Errorf("loaded %d textures, want 1", fake.loads)
+	}
+
+	//A single frame never animates
+	for i := 0; i < 5; i++ {
+		loaded.step(1, fake.updateTexture)
+	}
+	loaded.nextFrame(fake.updateTexture)
+	if loaded.CurrentFrame() != 0 || len(fake.uploads) != 0 {
+		t.Errorf("single frame gif moved to frame %d with %d uploads, want frame 0 and no uploads", loaded.CurrentFrame(), len(fake.uploads))
+	}
+}
+
+func TestLoadGifMalformed(t *testing.T) {
+	fake := &fakeTextures{}
+	valid := encodeTestGif(t, []*image.Paletted{solidFrame(4, 2, testRed)}, []int{10}, nil, 0)
+
+	tests := map[string][]byte{
+		"empty":     {},
+		"not a gif": []byte("this is not a gif"),
+		"truncated": valid[:len(valid)/2],
+		"no frames": []byte("GIF89a\x04\x00\x02\x00\x00\x00\x00\x3b"),
+	}
+
+	for name, data := range tests {
+		loaded, err := loadGif(bytes.NewReader(data), fake.loadTexture)
+		if err == nil || loaded != nil {
+			t.Errorf("%s: loadGif() = %v, %v, want an error", name, loaded, err)
+		}
+	}
+
+	if fake.loads != 0 {
+		t.Errorf("malformed gifs loaded %d textures, want 0", fake.loads)
+	}
+}
+
+func TestStepAdvancesFrames(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed, testGreen, testBlue)
+
+	//Each frame is shown for a tenth of a second
+	loaded.step(0.05, fake.updateTexture)
+	if loaded.CurrentFrame() != 0 {
+		t.Fatalf("frame %d after half a frame, want 0", loaded.CurrentFrame())
+	}
+	loaded.step(0.05, fake.updateTexture)
+	if loaded.CurrentFrame() != 1 {
+		t.Fatalf("frame %d after a whole frame, want 1", loaded.CurrentFrame())
+	}
+	if len(fake.uploads) != 1 || fake.uploads[0][0] != r.NewColor(0, 255, 0, 255) {
+		t.Errorf("uploads after advancing = %v, want the green frame", fake.uploads)
+	}
+
+	loaded.step(0.1, fake.updateTexture)
+	loaded.step(0.1, fake.updateTexture)
+	if loaded.CurrentFrame() != 0 {
+		t.Errorf("frame %d after the last frame, want to wrap to 0", loaded.CurrentFrame())
+	}
+}
+
+func TestSaveRoundTrip(t *testing.T) {
+	fake := &fakeTextures{}
+
+	frames := []*image.Paletted{solidFrame(4, 2, testRed), solidFrame(4, 2, testGreen), solidFrame(4, 2, testBlue)}
+	delays := []int{5, 10, 20}
+	disposal := []byte{gif.DisposalNone, gif.DisposalBackground, gif.DisposalNone}
+	original, err := loadGif(bytes.NewReader(encodeTestGif(t, frames, delays, disposal, 0)), fake.loadTexture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := original.SaveToWriter(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := loadGif(&buffer, fake.loadTexture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reloaded.Frames != original.Frames || reloaded.Width != original.Width || reloaded.Height != original.Height {
+		t.Fatalf("reloaded %d frames of %dx%d, want %d frames of %dx%d",
+			reloaded.Frames, reloaded.Width, reloaded.Height, original.Frames, original.Width, original.Height)
+	}
+
+	for i := 0; i < original.Frames; i++ {
+		if reloaded.Timing[i] != original.Timing[i] {
+			t.Errorf("frame %d timing = %d, want %d", i, reloaded.Timing[i], original.Timing[i])
+		}
+		if reloaded.Disposal[i] != original.Disposal[i] {
+			t.Errorf("frame %d disposal = %d, want %d", i, reloaded.Disposal[i], original.Disposal[i])
+		}
+
+		got, _ := reloaded.GetPixel(i, 3, 1)
+		want, _ := original.GetPixel(i, 3, 1)
+		if got != want {
+			t.Errorf("frame %d pixel = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestSaveWithoutFrames(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := (&GifImage{}).SaveToWriter(&buffer); err != ErrNoFrames {
+		t.Errorf("SaveToWriter() of an empty gif = %v, want ErrNoFrames", err)
+	}
+}
+
+//stepsToAdvance counts the steps of the duration until the gif leaves its current frame
+func stepsToAdvance(loaded *GifImage, fake *fakeTextures, step float32) int {
+	start := loaded.CurrentFrame()
+	for steps := 1; steps <= 1000; steps++ {
+		loaded.step(step, fake.updateTexture)
+		if loaded.CurrentFrame() != start {
+			return steps
+		}
+	}
+	return -1
+}
+
+func TestPlaybackSpeed(t *testing.T) {
+	fake := &fakeTextures{}
+
+	//Each frame is 0.1 seconds, stepped 0.01 seconds at a time
+	tests := []struct {
+		speed float32
+		steps int
+	}{
+		{1, 10},
+		{2, 5},
+		{0.5, 20},
+		{-1, -1},
+		{0, -1},
+	}
+
+	for _, test := range tests {
+		loaded := loadTestGif(t, fake, 10, testRed, testGreen)
+		loaded.SetSpeed(test.speed)
+		if steps := stepsToAdvance(loaded, fake, 0.01); steps != test.steps {
+			t.Errorf("speed %v advanced after %d steps, want %d", test.speed, steps, test.steps)
+		}
+	}
+
+	loaded := loadTestGif(t, fake, 10, testRed)
+	loaded.SetSpeed(-2)
+	if loaded.Speed() != 0 {
+		t.Errorf("Speed() after setting a negative speed = %v, want 0", loaded.Speed())
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed, testGreen)
+
+	loaded.step(0.05, fake.updateTexture)
+	loaded.Pause()
+	if !loaded.IsPaused() {
+		t.Fatal("IsPaused() = false after Pause()")
+	}
+
+	//Time does not build up while paused
+	loaded.step(1, fake.updateTexture)
+	if loaded.CurrentFrame() != 0 {
+		t.Fatalf("paused gif advanced to frame %d", loaded.CurrentFrame())
+	}
+
+	//Resuming continues from halfway through the frame
+	loaded.Resume()
+	if loaded.IsPaused() {
+		t.Fatal("IsPaused() = true after Resume()")
+	}
+	if steps := stepsToAdvance(loaded, fake, 0.01); steps != 5 {
+		t.Errorf("resumed gif advanced after %d steps, want 5", steps)
+	}
+}
+
+func TestLoopCountCompletes(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed, testGreen, testBlue)
+	loaded.LoopCount = 1
+
+	completions := 0
+	loaded.OnComplete = func() { completions++ }
+
+	//Step well past the end of the only loop
+	for i := 0; i < 100; i++ {
+		loaded.step(0.1, fake.updateTexture)
+	}
+
+	if completions != 1 {
+		t.Errorf("OnComplete called %d times, want 1", completions)
+	}
+	if !loaded.IsComplete() || loaded.Loops() != 1 {
+		t.Errorf("IsComplete() = %v with %d loops, want complete after 1 loop", loaded.IsComplete(), loaded.Loops())
+	}
+	if loaded.CurrentFrame() != 2 {
+		t.Errorf("completed gif is on frame %d, want to stay on the last frame 2", loaded.CurrentFrame())
+	}
+
+	//Resetting plays it again
+	loaded.Reset()
+	if loaded.IsComplete() || loaded.CurrentFrame() != 0 {
+		t.Errorf("Reset() left the gif complete on frame %d", loaded.CurrentFrame())
+	}
+}
+
+func TestLoopForever(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed, testGreen)
+
+	completions := 0
+	loaded.OnComplete = func() { completions++ }
+	for i := 0; i < 10; i++ {
+		loaded.step(0.1, fake.updateTexture)
+	}
+
+	//Every second step wraps back to the start
+	if completions != 5 || loaded.IsComplete() {
+		t.Errorf("OnComplete called %d times and IsComplete() = %v, want 5 calls and never complete", completions, loaded.IsComplete())
+	}
+}
+
+func TestGetPixel(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed, testGreen)
+
+	pixel, err := loaded.GetPixel(1, 3, 1)
+	if err != nil || pixel != r.NewColor(0, 255, 0, 255) {
+		t.Errorf("GetPixel(1, 3, 1) = %v, %v, want green", pixel, err)
+	}
+	pixel, err = loaded.GetPixel(0, 0, 0)
+	if err != nil || pixel != r.NewColor(255, 0, 0, 255) {
+		t.Errorf("GetPixel(0, 0, 0) = %v, %v, want red", pixel, err)
+	}
+
+	outOfBounds := [][3]int{{-1, 0, 0}, {2, 0, 0}, {0, -1, 0}, {0, 4, 0}, {0, 0, -1}, {0, 0, 2}}
+	for _, args := range outOfBounds {
+		if pixel, err := loaded.GetPixel(args[0], args[1], args[2]); err == nil {
+			t.Errorf("GetPixel(%d, %d, %d) = %v, want an error", args[0], args[1], args[2], pixel)
+		}
+	}
+}
+
+func TestRecolor(t *testing.T) {
+	fake := &fakeTextures{}
+	loaded := loadTestGif(t, fake, 10, testRed, testGreen)
+
+	red, green := r.NewColor(255, 0, 0, 255), r.NewColor(0, 255, 0, 255)
+	yellow := r.NewColor(255, 255, 0, 255)
+	loaded.recolor(map[r.Color]r.Color{red: yellow}, fake.updateTexture)
+
+	if pixel, _ := loaded.GetPixel(0, 2, 1); pixel != yellow {
+		t.Errorf("recoloured pixel = %v, want %v", pixel, yellow)
+	}
+	if pixel, _ := loaded.GetPixel(1, 2, 1); pixel != green {
+		t.Errorf("unmapped pixel = %v, want %v", pixel, green)
+	}
+
+	//The current frame is uploaded again so the change is visible
+	if len(fake.uploads) != 1 || fake.uploads[0][0] != yellow {
+		t.Errorf("uploads after recolor() = %v, want the recoloured frame", fake.uploads)
+	}
+
+	loaded.recolor(nil, fake.updateTexture)
+	if len(fake.uploads) != 1 {
+		t.Errorf("recolor() without a mapping uploaded the frame again")
+	}
+}
+
+func TestOnionSkin(t *testing.T) {
+	fake := &fakeTextures{}
+
+	//The middle frame is transparent, so the faded neighbours show through it
+	transparent := image.NewPaletted(image.Rect(0, 0, 4, 2), color.Palette{color.Transparent, testGreen})
+	frames := []*image.Paletted{solidFrame(4, 2, testRed), transparent, solidFrame(4, 2, testBlue)}
+	loaded, err := loadGif(bytes.NewReader(encodeTestGif(t, frames, []int{10, 10, 10}, nil, 0)), fake.loadTexture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skin := loaded.OnionSkin(1, 1, 1)
+	defer skin.Unload()
+	if skin.Width != 4 || skin.Height != 2 {
+		t.Fatalf("onion skin is %dx%d, want 4x2", skin.Width, skin.Height)
+	}
+
+	//Red at half opacity, then blue at half opacity over it
+	expected := r.NewColor(85, 0, 170, 192)
+	pixels := skin.GetPixels()
+	if pixels[5] != expected {
+		t.Errorf("blended pixel = %v, want %v", pixels[5], expected)
+	}
+
+	//Without neighbours, only the frame itself is drawn
+	alone := loaded.OnionSkin(0, 0, 0)
+	defer alone.Unload()
+	if pixel := alone.GetPixels()[0]; pixel != r.NewColor(255, 0, 0, 255) {
+		t.Errorf("onion skin without neighbours = %v, want red", pixel)
+	}
+}
+
+func TestOnionSkinOpacity(t *testing.T) {
+	tests := []struct {
+		distance, count int
+		expected        float32
+	}{
+		{1, 1, 0.5},
+		{1, 2, 0.5},
+		{2, 2, 0.25},
+		{3, 4, 0.25},
+	}
+
+	for _, test := range tests {
+		if opacity := onionSkinOpacity(test.distance, test.count); opacity != test.expected {
+			t.Errorf("onionSkinOpacity(%d, %d) = %v, want %v", test.distance, test.count, opacity, test.expected)
+		}
+	}
+}
+
+func TestCompositeOver(t *testing.T) {
+	dst := []r.Color{r.NewColor(255, 255, 255, 255), {}}
+	src := []r.Color{r.NewColor(0, 0, 0, 255), r.NewColor(0, 0, 255, 255)}
+	compositeOver(dst, src, 0.5)
+
+	expected := []r.Color{r.NewColor(128, 128, 128, 255), r.NewColor(0, 0, 255, 128)}
+	for i := range expected {
+		if dst[i] != expected[i] {
+			t.Errorf("composited pixel %d = %v, want %v", i, dst[i], expected[i])
+		}
+	}
+}
+
+func TestToSpriteAnimation(t *testing.T) {
+	fake := &fakeTextures{}
+
+	frames := []*image.Paletted{solidFrame(4, 2, testRed), solidFrame(4, 2, testGreen), solidFrame(4, 2, testBlue)}
+	loaded, err := loadGif(bytes.NewReader(encodeTestGif(t, frames, []int{5, 0, 25}, nil, 0)), fake.loadTexture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	anim := loaded.toSpriteAnimation(fake.loadTextureFromImage)
+	if len(anim.Frames) != 3 || len(anim.Durations) != 3 {
+		t.Fatalf("animation has %d frames and %d durations, want 3 of each", len(anim.Frames), len(anim.Durations))
+	}
+
+	//Frames without a delay are shown for a tenth of a second
+	expectedDurations := []float32{0.05, 0.1, 0.25}
+	for i, expected := range expectedDurations {
+		if anim.Durations[i] != expected {
+			t.Errorf("frame %d duration = %v, want %v", i, anim.Durations[i], expected)
+		}
+		if anim.Frames[i] != loaded.GetRectangle(i) {
+			t.Errorf("frame %d rectangle = %v, want %v", i, anim.Frames[i], loaded.GetRectangle(i))
+		}
+	}
+
+	//The frames are packed side by side into a 12x2 sheet
+	if anim.Texture.Width != 12 || anim.Texture.Height != 2 || len(fake.images) != 1 {
+		t.Fatalf("sheet texture is %dx%d, want 12x2", anim.Texture.Width, anim.Texture.Height)
+	}
+	sheet := fake.images[0]
+	expectedColors := []r.Color{r.NewColor(255, 0, 0, 255), r.NewColor(0, 255, 0, 255), r.NewColor(0, 0, 255, 255)}
+	for frame, expected := range expectedColors {
+		if pixel := sheet[12+frame*4+3]; pixel != expected {
+			t.Errorf("sheet pixel of frame %d = %v, want %v", frame, pixel, expected)
+		}
+	}
+}
+
+func TestLoopCountRoundTrip(t *testing.T) {
+	fake := &fakeTextures{}
+
+	frames := []*image.Paletted{solidFrame(2, 2, testRed), solidFrame(2, 2, testGreen)}
+	tests := []struct {
+		name          string
+		gifLoopCount  int
+		wantLoopCount int
+	}{
+		{"loops forever", 0, 0},
+		{"plays once", -1, 1},
+		{"repeats twice", 2, 3},
+	}
+
+	for _, test := range tests {
+		loaded, err := loadGif(bytes.NewReader(encodeTestGif(t, frames, []int{10, 10}, nil, test.gifLoopCount)), fake.loadTexture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if loaded.LoopCount != test.wantLoopCount {
+			t.Errorf("%s: loaded LoopCount = %d, want %d", test.name, loaded.LoopCount, test.wantLoopCount)
+		}
+
+		var buffer bytes.Buffer
+		if err := loaded.SaveToWriter(&buffer); err != nil {
+			t.Fatal(err)
+		}
+		reloaded, err := loadGif(&buffer, fake.loadTexture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reloaded.LoopCount != test.wantLoopCount {
+			t.Errorf("%s: reloaded LoopCount = %d, want %d", test.name, reloaded.LoopCount, test.wantLoopCount)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package raylib
+
+import (
+	"math"
+	"sort"
+)
+
+//AtlasPage is the grid coordinate of a page in a StreamingAtlas
+type AtlasPage struct {
+	X, Y int
+}
+
+//StreamingAtlas splits a huge world texture into a grid of pages and only keeps the pages near the view loaded.
+// Pages are loaded on demand as they become visible, and the least recently used pages are unloaded once there are too many.
+type StreamingAtlas struct {
+	//PageSize is the size of each page in world units
+	PageSize float32
+	//MaxPages is how many pages may be loaded at once. Visible pages are never unloaded, even if there are more than this.
+	MaxPages int
+	//Load loads the texture for a page. Returning an error leaves the page missing and it will be tried again next update.
+	Load func(page AtlasPage) (Texture2D, error)
+	//Unload unloads the texture of a page that is no longer needed
+	Unload func(page AtlasPage, texture Texture2D)
+
+	pages map[AtlasPage]*atlasEntry
+	frame uint64
+}
+
+type atlasEntry struct {
+	texture  Texture2D
+	lastUsed uint64
+}
+
+//NewStreamingAtlas creates a new atlas that loads pages with the loader. The textures are unloaded with UnloadTexture.
+func NewStreamingAtlas(pageSize float32, maxPages int, load func(page AtlasPage) (Texture2D, error)) *StreamingAtlas {
+	return &StreamingAtlas{
+		PageSize: pageSize,
+		MaxPages: maxPages,
+		Load:     load,
+		Unload:   func(page AtlasPage, texture Texture2D) { texture.Unload() },
+		pages:    make(map[AtlasPage]*atlasEntry),
+	}
+}
+
+//Update loads every page that overlaps the visible area of the world, then unloads old pages to stay within MaxPages.
+// This should be called once per frame with the area the camera can see.
+func (atlas *StreamingAtlas) Update(visible Rectangle) {
+	atlas.frame++
+
+	for _, page := range atlas.VisiblePages(visible) {
+		if entry, ok := atlas.pages[page]; ok {
+			entry.lastUsed = atlas.frame
+			continue
+		}
+
+		texture, err := atlas.Load(page)
+		if err != nil {
+			TraceLog(LogWarning, "[ATLAS] Failed to load page ", page.X, ", ", page.Y, ": ", err)
+			continue
+		}
+		atlas.pages[page] = &atlasEntry{texture: texture, lastUsed: atlas.frame}
+	}
+
+	for _, page := range atlas.evictions() {
+		atlas.Unload(page, atlas.pages[page].texture)
+		delete(atlas.pages, page)
+	}
+}
+
+//evictions picks the least recently used pages to unload so there are no more than MaxPages loaded.
+// Pages used this frame are never picked.
+func (atlas *StreamingAtlas) evictions() []AtlasPage {
+	excess := len(atlas.pages) - atlas.MaxPages
+	if atlas.MaxPages <= 0 || excess <= 0 {
+		return nil
+	}
+
+	candidates := make([]AtlasPage, 0, len(atlas.pages))
+	for page, entry := range atlas.pages {
+		if entry.lastUsed != atlas.frame {
+			candidates = append(candidates, page)
+		}
+	}
+
+	//Oldest first, with the page coordinate as a tie breaker so the order does not depend on the map
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := atlas.pages[candidates[i]], atlas.pages[candidates[j]]
+		if a.lastUsed != b.lastUsed {
+			return a.lastUsed < b.lastUsed
+		}
+		if candidates[i].Y != candidates[j].Y {
+			return candidates[i].Y < candidates[j].Y
+		}
+		return candidates[i].X < candidates[j].X
+	})
+
+	if excess > len(candidates) {
+		excess = len(candidates)
+	}
+	return candidates[:excess]
+}
+
+//VisiblePages gets every page that overlaps the area of the world
+func (atlas *StreamingAtlas) VisiblePages(area Rectangle) []AtlasPage {
+	if atlas.PageSize <= 0 || area.Width <= 0 || area.Height <= 0 {
+		return []AtlasPage{}
+	}
+
+	minX := int(math.Floor(float64(area.X / atlas.PageSize)))
+	minY := int(math.Floor(float64(area.Y / atlas.PageSize)))
+	maxX := int(math.Ceil(float64((area.X+area.Width)/atlas.PageSize))) - 1
+	maxY := int(math.Ceil(float64((area.Y+area.Height)/atlas.PageSize))) - 1
+
+	pages := make([]AtlasPage, 0, (maxX-minX+1)*(maxY-minY+1))
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			pages = append(pages, AtlasPage{x, y})
+		}
+	}
+	return pages
+}
+
+//PageBounds gets the area of the world a page covers
+func (atlas *StreamingAtlas) PageBounds(page AtlasPage) Rectangle {
+	return NewRectangle(float32(page.X)*atlas.PageSize, float32(page.Y)*atlas.PageSize, atlas.PageSize, atlas.PageSize)
+}
+
+//GetPage gets the texture of a page if it is loaded
+func (atlas *StreamingAtlas) GetPage(page AtlasPage) (Texture2D, bool) {
+	entry, ok := atlas.pages[page]
+	if !ok {
+		return Texture2D{}, false
+	}
+	return entry.texture, true
+}
+
+//IsLoaded checks if a page is loaded
+func (atlas *StreamingAtlas) IsLoaded(page AtlasPage) bool {
+	_, ok := atlas.pages[page]
+	return ok
+}
+
+//LoadedCount is the number of pages currently loaded
+func (atlas *StreamingAtlas) LoadedCount() int { return len(atlas.pages) }
+
+//Draw draws every loaded page that overlaps the visible area, stretched over the area of the world it covers.
+// This should be drawn in 2D mode.
+func (atlas *StreamingAtlas) Draw(visible Rectangle, tint Color) {
+	for _, page := range atlas.VisiblePages(visible) {
+		if texture, ok := atlas.GetPage(page); ok {
+			source := NewRectangle(0, 0, float32(texture.Width), float32(texture.Height))
+			DrawTexturePro(texture, source, atlas.PageBounds(page), NewVector2Zero(), 0, tint)
+		}
+	}
+}
+
+//UnloadAll unloads every loaded page
+func (atlas *StreamingAtlas) UnloadAll() {
+	for page, entry := range atlas.pages {
+		atlas.Unload(page, entry.texture)
+	}
+	atlas.pages = make(map[AtlasPage]*atlasEntry)
+}
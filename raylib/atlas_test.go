@@ -0,0 +1,126 @@
+package raylib
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+//fakeAtlas creates an atlas that records the pages it loads and unloads instead of using textures
+func fakeAtlas(maxPages int) (atlas *StreamingAtlas, loads, unloads *[]AtlasPage) {
+	loads = &[]AtlasPage{}
+	unloads = &[]AtlasPage{}
+	atlas = NewStreamingAtlas(100, maxPages, func(page AtlasPage) (Texture2D, error) {
+		*loads = append(*loads, page)
+		return Texture2D{Id: uint32(len(*loads))}, nil
+	})
+	atlas.Unload = func(page AtlasPage, texture Texture2D) { *unloads = append(*unloads, page) }
+	return
+}
+
+//sortPages sorts the pages by row then column so they can be compared
+func sortPages(pages []AtlasPage) []AtlasPage {
+	sort.Slice(pages, func(i, j int) bool {
+		if pages[i].Y != pages[j].Y {
+			return pages[i].Y < pages[j].Y
+		}
+		return pages[i].X < pages[j].X
+	})
+	return pages
+}
+
+func TestStreamingAtlasMovingViewport(t *testing.T) {
+	atlas, loads, unloads := fakeAtlas(4)
+
+	//The view covers 2x2 pages, moving one page to the right each step and then back again
+	steps := []struct {
+		x       float32
+		loads   []AtlasPage
+		unloads []AtlasPage
+	}{
+		{0, []AtlasPage{{0, 0}, {1, 0}, {0, 1}, {1, 1}}, []AtlasPage{}},
+		{100, []AtlasPage{{2, 0}, {2, 1}}, []AtlasPage{{0, 0}, {0, 1}}},
+		{150, []AtlasPage{}, []AtlasPage{}},
+		{200, []AtlasPage{{3, 0}, {3, 1}}, []AtlasPage{{1, 0}, {1, 1}}},
+		{100, []AtlasPage{{1, 0}, {1, 1}}, []AtlasPage{{3, 0}, {3, 1}}},
+	}
+
+	for i, step := range steps {
+		*loads, *unloads = (*loads)[:0], (*unloads)[:0]
+		visible := NewRectangle(step.x, 0, 150, 150)
+		atlas.Update(visible)
+
+		if !reflect.DeepEqual(sortPages(*loads), step.loads) {
+			t.Errorf("step %d: loaded %v, want %v", i, *loads, step.loads)
+		}
+		if !reflect.DeepEqual(sortPages(*unloads), step.unloads) {
+			t.Errorf("step %d: unloaded %v, want %v", i, *unloads, step.unloads)
+		}
+		for _, page := range atlas.VisiblePages(visible) {
+			if !atlas.IsLoaded(page) {
+				t.Errorf("step %d: visible page %v is not loaded", i, page)
+			}
+		}
+	}
+}
+
+func TestStreamingAtlasKeepsVisiblePages(t *testing.T) {
+	atlas, _, unloads := fakeAtlas(2)
+
+	atlas.Update(NewRectangle(50, 50, 150, 150))
+	if count := atlas.LoadedCount(); count != 4 {
+		t.Errorf("LoadedCount() = %d, want all 4 visible pages even though MaxPages is 2", count)
+	}
+	if len(*unloads) != 0 {
+		t.Errorf("unloaded %v while they were visible, want nothing", *unloads)
+	}
+
+	//Moving away drops back down to the limit
+	atlas.Update(NewRectangle(1000, 1000, 10, 10))
+	if count := atlas.LoadedCount(); count != 2 {
+		t.Errorf("LoadedCount() after moving away = %d, want 2", count)
+	}
+}
+
+func TestStreamingAtlasLoadError(t *testing.T) {
+	atlas, _, _ := fakeAtlas(4)
+	attempts := 0
+	atlas.Load = func(page AtlasPage) (Texture2D, error) {
+		attempts++
+		if attempts == 1 {
+			return Texture2D{}, errors.New("not ready")
+		}
+		return Texture2D{Id: 1}, nil
+	}
+
+	view := NewRectangle(10, 10, 10, 10)
+	atlas.Update(view)
+	if atlas.IsLoaded(AtlasPage{0, 0}) {
+		t.Error("page is loaded after its loader failed")
+	}
+
+	atlas.Update(view)
+	if !atlas.IsLoaded(AtlasPage{0, 0}) || attempts != 2 {
+		t.Errorf("page was not loaded on the next update, after %d attempts", attempts)
+	}
+}
+
+func TestStreamingAtlasVisiblePages(t *testing.T) {
+	atlas, _, _ := fakeAtlas(4)
+
+	tests := []struct {
+		area     Rectangle
+		expected []AtlasPage
+	}{
+		{NewRectangle(0, 0, 100, 100), []AtlasPage{{0, 0}}},
+		{NewRectangle(-10, 90, 20, 20), []AtlasPage{{-1, 0}, {0, 0}, {-1, 1}, {0, 1}}},
+		{NewRectangle(0, 0, 0, 100), []AtlasPage{}},
+	}
+
+	for _, test := range tests {
+		if actual := atlas.VisiblePages(test.area); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("VisiblePages(%v) = %v, want %v", test.area, actual, test.expected)
+		}
+	}
+}
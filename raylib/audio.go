@@ -5,7 +5,10 @@ package raylib
 #include <stdlib.h>
 */
 import "C"
-import "unsafe"
+import (
+	"math"
+	"unsafe"
+)
 
 //Wave defines audio wave data
 type Wave struct {
@@ -65,6 +68,37 @@ func (as *AudioStream) IsValid() bool {
 	return as.Channels > 0
 }
 
+//LoadAudioStream creates a stream to play audio generated in Go, such as procedural sound.
+// The sample size is the bits per sample, and should be 16 for UpdateInt16 or 32 for UpdateFloat32.
+// The stream is registered as an Unloadable. This is the same as InitAudioStream.
+func LoadAudioStream(sampleRate, sampleSize, channels uint32) *AudioStream {
+	return InitAudioStream(sampleRate, sampleSize, channels)
+}
+
+//UpdateInt16 fills the next buffer of a 16 bit stream with samples, with the channels interleaved.
+// Use IsProcessed to check if the stream needs more data.
+func (as *AudioStream) UpdateInt16(data []int16) {
+	if as.SampleSize != 16 {
+		TraceLog(LogWarning, "[AUDIO] Cannot update a ", as.SampleSize, " bit stream with 16 bit samples")
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+
+	C.UpdateAudioStream(*as.cptr(), unsafe.Pointer(&data[0]), C.int(int32(len(data))))
+}
+
+//UpdateFloat32 fills the next buffer of a 32 bit stream with samples between -1 and 1, with the channels interleaved.
+// Use IsProcessed to check if the stream needs more data.
+func (as *AudioStream) UpdateFloat32(data []float32) {
+	if as.SampleSize != 32 {
+		TraceLog(LogWarning, "[AUDIO] Cannot update a ", as.SampleSize, " bit stream with 32 bit samples")
+		return
+	}
+	as.Update(data, len(data))
+}
+
 //Music stream type. Anything longer than ~10 seconds should be streamed.
 type Music struct {
 	CtxType     int32
@@ -86,3 +120,66 @@ func (music *Music) cptr() *C.Music {
 func (music *Music) IsValid() bool {
 	return music.Stream.IsValid()
 }
+
+//RMSWindows splits the wave into windows of windowSize frames and calculates the root mean square of each.
+// This is the average loudness of each window and is useful for drawing waveforms. The final window may be partial.
+// The channels are not split, so each window of a stereo wave covers both its left and right samples.
+func (w Wave) RMSWindows(windowSize int) []float32 {
+	return rmsWindows(GetWaveData(w), windowSize*int(w.Channels))
+}
+
+//PeakWindows splits the wave into windows of windowSize frames and finds the peak absolute amplitude of each.
+// This is useful for drawing waveforms. The final window may be partial.
+// The channels are not split, so each window of a stereo wave covers both its left and right samples.
+func (w Wave) PeakWindows(windowSize int) []float32 {
+	return peakWindows(GetWaveData(w), windowSize*int(w.Channels))
+}
+
+func rmsWindows(samples []float32, windowSize int) []float32 {
+	if windowSize <= 0 {
+		return []float32{}
+	}
+
+	windows := make([]float32, 0, (len(samples)+windowSize-1)/windowSize)
+	for start := 0; start < len(samples); start += windowSize {
+		end := start + windowSize
+		if end > len(samples) {
+			end = len(samples)
+		}
+
+		sum := float64(0)
+		for _, s := range samples[start:end] {
+			sum += float64(s) * float64(s)
+		}
+		windows = append(windows, float32(math.Sqrt(sum/float64(end-start))))
+	}
+
+	return windows
+}
+
+func peakWindows(samples []float32, windowSize int) []float32 {
+	if windowSize <= 0 {
+		return []float32{}
+	}
+
+	windows := make([]float32, 0, (len(samples)+windowSize-1)/windowSize)
+	for start := 0; start < len(samples); start += windowSize {
+		end := start + windowSize
+		if end > len(samples) {
+			end = len(samples)
+		}
+
+		peak := float32(0)
+		for _, s := range samples[start:end] {
+			if s < 0 {
+				s = -s
+			}
+			if s > peak {
+				peak = s
+			}
+		}
+		windows = append(windows, peak)
+	}
+
+	return windows
+}
@@ -209,10 +209,10 @@ func IsSoundPlaying(sound *Sound) bool {
 	return sound.IsPlaying()
 }
 
-//SetVolume : Set volume for a sound (1.0 is max level)
+//SetVolume : Set volume for a sound (1.0 is max level). The volume is clamped between 0 and 1.
 func (sound *Sound) SetVolume(volume float32) {
 	csound := *sound.cptr()
-	C.SetSoundVolume(csound, C.float(volume))
+	C.SetSoundVolume(csound, C.float(Clamp32(volume, 0, 1)))
 }
 
 //SetSoundVolume : Set volume for a sound (1.0 is max level)
@@ -221,8 +221,12 @@ func SetSoundVolume(sound *Sound, volume float32) {
 	sound.SetVolume(volume)
 }
 
-//SetPitch : Set pitch for a sound (1.0 is base level)
+//SetPitch : Set pitch for a sound (1.0 is base level). The pitch is at least MinSoundPitch.
 func (sound *Sound) SetPitch(pitch float32) {
+	if pitch < MinSoundPitch {
+		pitch = MinSoundPitch
+	}
+
 	csound := *sound.cptr()
 	C.SetSoundPitch(csound, C.float(pitch))
 }
@@ -279,12 +283,12 @@ func GetWaveData(wave Wave) []float32 {
 	cwave := *wave.cptr()
 
 	res := C.GetWaveData(cwave)
-	tmpslice := (*[1 << 24]*C.float)(unsafe.Pointer(res))[:samples:samples]
+	tmpslice := (*[1 << 24]C.float)(unsafe.Pointer(res))[:samples:samples]
 	defer C.free(unsafe.Pointer(res))
 
 	gostrings := make([]float32, samples)
 	for i, s := range tmpslice {
-		gostrings[i] = float32(*s)
+		gostrings[i] = float32(s)
 	}
 
 	return gostrings
@@ -409,10 +413,14 @@ func SetMusicPitch(music *Music, pitch float32) {
 	music.SetPitch(pitch)
 }
 
-//SetLoopCount : Set music loop count (loop repeats)
+//SetLoopCount : Set music loop count (loop repeats). A count of 0 loops forever.
 func (music *Music) SetLoopCount(count int) {
-	cmusic := *music.cptr()
-	C.SetMusicLoopCount(cmusic, C.int(int32(count)))
+	if count < 0 {
+		count = 0
+	}
+
+	//raylib sets the count on a copy of the music, so it must be set here instead
+	music.LoopCount = uint32(count)
 }
 
 //SetMusicLoopCount : Set music loop count (loop repeats)
@@ -455,8 +463,19 @@ func InitAudioStream(sampleRate uint32, sampleSize uint32, channels uint32) *Aud
 	return retval
 }
 
-//Update : Update audio stream buffers with data
+//Update : Update audio stream buffers with data. The samples count is the number of samples for all channels,
+// and is limited to the size of the data. Nothing is updated if there are no samples. See UpdateInt16 and UpdateFloat32.
 func (stream *AudioStream) Update(data []float32, samplesCount int) {
+	if stream.SampleSize > 0 {
+		available := len(data) * 32 / int(stream.SampleSize)
+		if samplesCount > available {
+			samplesCount = available
+		}
+	}
+	if samplesCount <= 0 || len(data) == 0 {
+		return
+	}
+
 	cstream := *stream.cptr()
 	C.UpdateAudioStream(cstream, unsafe.Pointer(&data[0]), C.int(int32(samplesCount)))
 }
@@ -471,6 +490,7 @@ func UpdateAudioStream(stream *AudioStream, data []float32, samplesCount int) {
 func (stream *AudioStream) Unload() {
 	cstream := *stream.cptr()
 	C.CloseAudioStream(cstream)
+	UnregisterUnloadable(stream)
 }
 
 //CloseAudioStream : Close audio stream and free memory
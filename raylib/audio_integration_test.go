@@ -0,0 +1,86 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import "testing"
+
+func TestAudioStreamIsProcessed(t *testing.T) {
+	requireAudio(t)
+
+	var afterCreate, afterOne, afterBoth bool
+	onMainThread(func() {
+		stream := LoadAudioStream(44100, 16, 1)
+		defer stream.Unload()
+
+		//Both halves of the buffer start empty, and each update fills one of them
+		samples := make([]int16, 1024)
+		afterCreate = stream.IsProcessed()
+		stream.UpdateInt16(samples)
+		afterOne = stream.IsProcessed()
+		stream.UpdateInt16(samples)
+		afterBoth = stream.IsProcessed()
+	})
+
+	if !afterCreate || !afterOne {
+		t.Errorf("IsProcessed() = %v after creating and %v after one update, want true for both", afterCreate, afterOne)
+	}
+	if afterBoth {
+		t.Error("IsProcessed() after filling both buffers = true, want false")
+	}
+}
+
+func TestSoundPlayIsPlaying(t *testing.T) {
+	requireAudio(t)
+	fileName := writeTestWave(t, make([]int16, 44100), 44100)
+
+	var before, playing, stopped bool
+	onMainThread(func() {
+		wave := LoadWave(fileName)
+		defer wave.Unload()
+		sound := LoadSoundFromWave(wave)
+		defer sound.Unload()
+
+		before = sound.IsPlaying()
+		sound.Play()
+		playing = sound.IsPlaying()
+		sound.Stop()
+		stopped = sound.IsPlaying()
+	})
+
+	if before || stopped {
+		t.Errorf("IsPlaying() = %v before Play() and %v after Stop(), want false for both", before, stopped)
+	}
+	if !playing {
+		t.Error("IsPlaying() after Play() = false, want true")
+	}
+}
+
+func TestPannedSound(t *testing.T) {
+	requireAudio(t)
+	fileName := writeTestWave(t, make([]int16, 44100), 44100)
+
+	var playing, panned, streaming, stopped bool
+	onMainThread(func() {
+		wave := LoadWave(fileName)
+		defer wave.Unload()
+		sound := LoadPannedSound(wave)
+		defer sound.Unload()
+
+		sound.Play()
+		playing = sound.IsPlaying()
+		sound.SetPan(-0.5)
+		sound.Update()
+		panned = sound.IsPlaying()
+		streaming = sound.Stream.IsPlaying()
+		sound.Stop()
+		stopped = sound.IsPlaying() || sound.Stream.IsPlaying()
+	})
+
+	if !playing || !panned || !streaming {
+		t.Errorf("IsPlaying() = %v after Play() and %v after SetPan(), with the stream playing %v, want true for all", playing, panned, streaming)
+	}
+	if stopped {
+		t.Error("sound is still playing after Stop()")
+	}
+}
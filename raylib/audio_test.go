@@ -0,0 +1,97 @@
+package raylib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+//writeTestWave writes the 16 bit mono samples to a wav file and returns its path
+func writeTestWave(t testing.TB, samples []int16, sampleRate uint32) string {
+	t.Helper()
+	return writeTestWaveChannels(t, samples, sampleRate, 1)
+}
+
+//writeTestWaveChannels writes the 16 bit samples, with the channels interleaved, to a wav file and returns its path
+func writeTestWaveChannels(t testing.TB, samples []int16, sampleRate uint32, channels uint16) string {
+	t.Helper()
+
+	var data bytes.Buffer
+	binary.Write(&data, binary.LittleEndian, samples)
+
+	var file bytes.Buffer
+	file.WriteString("RIFF")
+	binary.Write(&file, binary.LittleEndian, uint32(36+data.Len()))
+	file.WriteString("WAVEfmt ")
+	binary.Write(&file, binary.LittleEndian, struct {
+		Size          uint32
+		Format        uint16
+		Channels      uint16
+		SampleRate    uint32
+		ByteRate      uint32
+		BlockAlign    uint16
+		BitsPerSample uint16
+	}{16, 1, channels, sampleRate, sampleRate * 2 * uint32(channels), 2 * channels, 16})
+	file.WriteString("data")
+	binary.Write(&file, binary.LittleEndian, uint32(data.Len()))
+	file.Write(data.Bytes())
+
+	fileName := filepath.Join(t.TempDir(), "test.wav")
+	if err := ioutil.WriteFile(fileName, file.Bytes(), 0644); err != nil {
+		t.Fatalf("failed to write the test wave: %v", err)
+	}
+	return fileName
+}
+
+func TestWaveWindows(t *testing.T) {
+	//Stereo frames of left and right: 0.5 and -0.25 twice, then -0.75 and 0, silence, and 0.25 on both
+	samples := []int16{16384, -8192, 16384, -8192, -24576, 0, 0, 0, 8192, 8192}
+	wave := LoadWave(writeTestWaveChannels(t, samples, 22050, 2))
+	defer wave.Unload()
+
+	if wave.Channels != 2 {
+		t.Fatalf("wave has %d channels, want 2", wave.Channels)
+	}
+
+	//Windows of 2 frames cover 4 samples each, with the final frame in a partial window
+	peaks := wave.PeakWindows(2)
+	expectedPeaks := []float32{0.5, 0.75, 0.25}
+	if len(peaks) != len(expectedPeaks) {
+		t.Fatalf("PeakWindows(2) = %v, want %v", peaks, expectedPeaks)
+	}
+	for i := range expectedPeaks {
+		if !nearlyEqual(peaks[i], expectedPeaks[i]) {
+			t.Errorf("PeakWindows(2)[%d] = %v, want %v", i, peaks[i], expectedPeaks[i])
+		}
+	}
+
+	rms := wave.RMSWindows(2)
+	expectedRMS := []float32{0.3952847, 0.375, 0.25}
+	if len(rms) != len(expectedRMS) {
+		t.Fatalf("RMSWindows(2) = %v, want %v", rms, expectedRMS)
+	}
+	for i := range expectedRMS {
+		if !nearlyEqual(rms[i], expectedRMS[i]) {
+			t.Errorf("RMSWindows(2)[%d] = %v, want %v", i, rms[i], expectedRMS[i])
+		}
+	}
+
+	//A window larger than the wave covers all of it
+	if peaks := wave.PeakWindows(100); len(peaks) != 1 || !nearlyEqual(peaks[0], 0.75) {
+		t.Errorf("PeakWindows(100) = %v, want [0.75]", peaks)
+	}
+}
+
+func TestWaveWindowsInvalidSize(t *testing.T) {
+	samples := []float32{0.5, -0.5, 0.25}
+	for _, size := range []int{0, -1} {
+		if windows := rmsWindows(samples, size); len(windows) != 0 {
+			t.Errorf("rmsWindows(%d) = %v, want no windows", size, windows)
+		}
+		if windows := peakWindows(samples, size); len(windows) != 0 {
+			t.Errorf("peakWindows(%d) = %v, want no windows", size, windows)
+		}
+	}
+}
@@ -5,7 +5,10 @@ package raylib
 #include <stdlib.h>
 */
 import "C"
-import "unsafe"
+import (
+	"math"
+	"unsafe"
+)
 
 type CameraType int32
 
@@ -78,3 +81,74 @@ func newCamera2DFromPointer(ptr unsafe.Pointer) *Camera2D {
 func (w *Camera2D) cptr() *C.Camera2D {
 	return (*C.Camera2D)(unsafe.Pointer(w))
 }
+
+//GetScreenToWorldRay gets the ray from the camera through the screen position, such as for picking 3D objects with the mouse
+func (camera *Camera) GetScreenToWorldRay(position Vector2) Ray {
+	ccamera := *camera.cptr()
+	cposition := *position.cptr()
+	res := C.GetMouseRay(cposition, ccamera)
+	return newRayFromPointer(unsafe.Pointer(&res))
+}
+
+//GetWorldToScreen gets the screen space position of a 3D world space position
+func (camera *Camera) GetWorldToScreen(position Vector3) Vector2 {
+	ccamera := *camera.cptr()
+	cposition := *position.cptr()
+	res := C.GetWorldToScreen(cposition, ccamera)
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}
+
+//Indices of the planes returned by GetFrustumPlanes
+const (
+	FrustumLeft = iota
+	FrustumRight
+	FrustumTop
+	FrustumBottom
+	FrustumNear
+	FrustumFar
+)
+
+//GetFrustumPlanes gets the six planes of the camera's view frustum, such as for culling objects that are out of view.
+// Each plane has its normal in XYZ pointing into the frustum and its distance in W, so a point p is inside a plane when
+// p.X*X + p.Y*Y + p.Z*Z + W >= 0. The planes are in the order of the Frustum constants, and use the same near and far
+// clipping distances as BeginMode3D.
+func (camera *Camera) GetFrustumPlanes(aspect float32) [6]Vector4 {
+	const near, far = 0.01, 1000.0
+
+	var projection Matrix
+	if camera.Type == CameraTypeOrthographic {
+		top := float64(camera.FOVY) / 2
+		right := top * float64(aspect)
+		projection = NewMatrixOrtho(-right, right, -top, top, near, far)
+	} else {
+		projection = NewMatrixPerspective(float64(camera.FOVY*Deg2Rad), float64(aspect), near, far)
+	}
+
+	//GetCameraMatrix comes back in C's column major layout, while NewMatrixPerspective and NewMatrixOrtho are built in Go's
+	// field order, so the view matrix is transposed to match them before combining
+	m := GetCameraMatrix(*camera).Transpose().Multiply(projection)
+
+	//Rows of the combined matrix, which is stored column major
+	row0 := NewVector4(m.M0, m.M4, m.M8, m.M12)
+	row1 := NewVector4(m.M1, m.M5, m.M9, m.M13)
+	row2 := NewVector4(m.M2, m.M6, m.M10, m.M14)
+	row3 := NewVector4(m.M3, m.M7, m.M11, m.M15)
+
+	planes := [6]Vector4{
+		FrustumLeft:   row3.Add(row0),
+		FrustumRight:  row3.Subtract(row0),
+		FrustumTop:    row3.Subtract(row1),
+		FrustumBottom: row3.Add(row1),
+		FrustumNear:   row3.Add(row2),
+		FrustumFar:    row3.Subtract(row2),
+	}
+
+	for i, plane := range planes {
+		length := float32(math.Sqrt(float64(plane.X*plane.X + plane.Y*plane.Y + plane.Z*plane.Z)))
+		if length > 0 {
+			planes[i] = plane.Scale(1 / length)
+		}
+	}
+
+	return planes
+}
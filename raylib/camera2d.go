@@ -0,0 +1,124 @@
+package raylib
+
+import "math"
+
+//DeadzoneCamera is a Camera2D that follows a target, but only moves once the target leaves the deadzone.
+// This avoids the jittery following that comes from always centering on the target.
+type DeadzoneCamera struct {
+	//Camera is the camera being moved. Use this with BeginMode2D.
+	Camera Camera2D
+	//Deadzone is the area in screen space the target can move freely within without moving the camera.
+	Deadzone Rectangle
+}
+
+//NewDeadzoneCamera creates a new camera that follows with the deadzone in screen space
+func NewDeadzoneCamera(camera Camera2D, deadzone Rectangle) *DeadzoneCamera {
+	return &DeadzoneCamera{Camera: camera, Deadzone: deadzone}
+}
+
+//NewDeadzoneCameraCentered creates a new camera with a deadzone of the given size centered around the camera's offset.
+// The offset is usually the center of the screen.
+func NewDeadzoneCameraCentered(camera Camera2D, width, height float32) *DeadzoneCamera {
+	deadzone := NewRectangle(camera.Offset.X-width/2, camera.Offset.Y-height/2, width, height)
+	return NewDeadzoneCamera(camera, deadzone)
+}
+
+//Update moves the camera just enough so the target is back within the deadzone.
+// If the target is already within the deadzone, the camera will not move.
+func (dc *DeadzoneCamera) Update(target Vector2) {
+	zoom := dc.Camera.Zoom
+	if zoom == 0 {
+		zoom = 1
+	}
+
+	//Where the target is on the screen (rotation is ignored)
+	screen := target.Subtract(dc.Camera.Target).Scale(zoom).Add(dc.Camera.Offset)
+
+	//How far it has left the deadzone
+	var shift Vector2
+	min, max := dc.Deadzone.MinPosition(), dc.Deadzone.MaxPosition()
+	if screen.X < min.X {
+		shift.X = screen.X - min.X
+	} else if screen.X > max.X {
+		shift.X = screen.X - max.X
+	}
+	if screen.Y < min.Y {
+		shift.Y = screen.Y - min.Y
+	} else if screen.Y > max.Y {
+		shift.Y = screen.Y - max.Y
+	}
+
+	dc.Camera.Target = dc.Camera.Target.Add(shift.Divide(zoom))
+}
+
+//cameraMinZoom is the smallest zoom ZoomToCursor allows, as a zoom of 0 cannot convert between screen and world space
+const cameraMinZoom = 0.001
+
+//ZoomToCursor zooms the camera by the mouse wheel movement while keeping the world point under the cursor stationary.
+// Each step of the wheel zooms by 10%, and the zoom is clamped between minZoom and maxZoom.
+// A minZoom below 0.001 is raised to 0.001 so the zoom never reaches 0, and a maxZoom below minZoom is raised to minZoom.
+func (camera *Camera2D) ZoomToCursor(wheelDelta float32, mousePos Vector2, minZoom, maxZoom float32) {
+	if wheelDelta == 0 {
+		return
+	}
+
+	if minZoom < cameraMinZoom {
+		minZoom = cameraMinZoom
+	}
+	if maxZoom < minZoom {
+		maxZoom = minZoom
+	}
+
+	if camera.Zoom == 0 {
+		camera.Zoom = 1
+	}
+
+	//Find what we are pointing at before we zoom
+	world := camera.screenToWorld(mousePos)
+
+	camera.Zoom = Clamp32(camera.Zoom*float32(math.Pow(1.1, float64(wheelDelta))), minZoom, maxZoom)
+
+	//Move the target so the same world point is back under the cursor
+	camera.Target = camera.Target.Add(world.Subtract(camera.screenToWorld(mousePos)))
+}
+
+//screenToWorld converts a screen position into world space, taking into account the offset, rotation and zoom.
+func (camera *Camera2D) screenToWorld(position Vector2) Vector2 {
+	return position.Subtract(camera.Offset).Divide(camera.Zoom).RotateByRadians(-camera.Rotation * Deg2Rad).Add(camera.Target)
+}
+
+//MouseWorldPosition gets the position of the mouse in the world as seen through the camera
+func MouseWorldPosition(camera Camera2D) Vector2 {
+	return GetScreenToWorld2D(GetMousePosition(), camera)
+}
+
+//MouseWorldDelta gets how far the mouse has moved in the world since it was at the previous screen position.
+// This is useful for dragging objects or panning, as it accounts for the zoom and rotation of the camera.
+func MouseWorldDelta(camera Camera2D, previousMouse Vector2) Vector2 {
+	return mouseWorldDelta(camera, GetMousePosition(), previousMouse)
+}
+
+//mouseWorldDelta gets how far the mouse has moved in the world between the two screen positions
+func mouseWorldDelta(camera Camera2D, mouse, previousMouse Vector2) Vector2 {
+	return GetScreenToWorld2D(mouse, camera).Subtract(GetScreenToWorld2D(previousMouse, camera))
+}
+
+//cameraFollowSnapDistance is how close the camera target has to be before FollowTarget snaps to it
+const cameraFollowSnapDistance = 0.01
+
+//FollowTarget moves the camera target smoothly towards the target, covering a fraction of the remaining distance each frame.
+// Smoothing is how quickly it catches up, where higher values follow more tightly. Because the movement is exponential,
+// it is the same regardless of the frame rate. Once close enough, the camera snaps to the target to avoid jittering.
+func (camera *Camera2D) FollowTarget(target Vector2, delta, smoothing float32) {
+	if smoothing <= 0 {
+		camera.Target = target
+		return
+	}
+
+	t := 1 - float32(math.Exp(float64(-smoothing*delta)))
+	camera.Target = camera.Target.Lerp(target, t)
+
+	if camera.Target.Distance(target) < cameraFollowSnapDistance {
+		camera.Target = target
+	}
+}
@@ -0,0 +1,144 @@
+package raylib
+
+import "testing"
+
+func TestDeadzoneCamera(t *testing.T) {
+	camera := Camera2D{Offset: NewVector2(400, 300), Target: NewVector2(0, 0), Zoom: 1}
+	dc := NewDeadzoneCameraCentered(camera, 200, 100)
+
+	steps := []struct {
+		name     string
+		target   Vector2
+		expected Vector2
+	}{
+		{"center", NewVector2(0, 0), NewVector2(0, 0)},
+		{"inside", NewVector2(90, -40), NewVector2(0, 0)},
+		{"on the edge", NewVector2(100, 50), NewVector2(0, 0)},
+		{"exit right", NewVector2(130, 0), NewVector2(30, 0)},
+		{"back inside", NewVector2(50, 0), NewVector2(30, 0)},
+		{"exit top left", NewVector2(-100, -80), NewVector2(0, -30)},
+	}
+
+	for _, step := range steps {
+		dc.Update(step.target)
+		if !vector2NearlyEqual(dc.Camera.Target, step.expected) {
+			t.Errorf("%s: target after Update(%v) = %v, want %v", step.name, step.target, dc.Camera.Target, step.expected)
+		}
+	}
+}
+
+func TestDeadzoneCameraZoomed(t *testing.T) {
+	//At double zoom, the deadzone covers half as much of the world
+	camera := Camera2D{Offset: NewVector2(400, 300), Zoom: 2}
+	dc := NewDeadzoneCameraCentered(camera, 200, 100)
+
+	dc.Update(NewVector2(50, 0))
+	if !vector2NearlyEqual(dc.Camera.Target, Vector2{}) {
+		t.Errorf("target after moving to the edge = %v, want unchanged", dc.Camera.Target)
+	}
+
+	dc.Update(NewVector2(60, 0))
+	if !vector2NearlyEqual(dc.Camera.Target, NewVector2(10, 0)) {
+		t.Errorf("target after leaving the deadzone = %v, want %v", dc.Camera.Target, NewVector2(10, 0))
+	}
+}
+
+func TestZoomToCursor(t *testing.T) {
+	mouse := NewVector2(620, 130)
+	tests := []struct {
+		name     string
+		wheel    float32
+		min, max float32
+		zoom     float32
+	}{
+		{"zoom in", 1, 0.1, 4, 1.1},
+		{"zoom out", -3, 0.1, 4, 1 / (1.1 * 1.1 * 1.1)},
+		{"clamped", 50, 0.1, 4, 4},
+		{"no movement", 0, 0.1, 4, 1},
+		{"zero min", -200, 0, 4, cameraMinZoom},
+		{"negative min", -200, -1, 4, cameraMinZoom},
+		{"max below min", 1, 3, 2, 3},
+	}
+
+	for _, test := range tests {
+		camera := Camera2D{Offset: NewVector2(400, 300), Target: NewVector2(50, -20), Rotation: 30, Zoom: 1}
+		before := camera.screenToWorld(mouse)
+
+		camera.ZoomToCursor(test.wheel, mouse, test.min, test.max)
+		if !nearlyEqual(camera.Zoom, test.zoom) {
+			t.Errorf("%s: zoom = %v, want %v", test.name, camera.Zoom, test.zoom)
+		}
+
+		//The same world point should still be under the cursor, according to raylib too.
+		// This is checked on the screen, as world positions lose precision when zoomed far out.
+		if screen := GetWorldToScreen2D(before, camera); !vector2NearlyEqual(screen, mouse) {
+			t.Errorf("%s: world point %v moved from under the cursor to %v", test.name, before, screen)
+		}
+	}
+}
+
+func TestMouseWorldPosition(t *testing.T) {
+	mouse := NewVector2(500, 200)
+
+	tests := []struct {
+		name     string
+		camera   Camera2D
+		expected Vector2
+	}{
+		{"identity", Camera2D{Zoom: 1}, NewVector2(500, 200)},
+		{"offset", Camera2D{Offset: NewVector2(400, 300), Target: NewVector2(10, 20), Zoom: 1}, NewVector2(110, -80)},
+		{"zoomed", Camera2D{Offset: NewVector2(400, 300), Target: NewVector2(10, 20), Zoom: 2}, NewVector2(60, -30)},
+		{"rotated", Camera2D{Offset: NewVector2(400, 300), Rotation: 90, Zoom: 1}, NewVector2(-100, -100)},
+	}
+
+	//MouseWorldPosition is the mouse converted with GetScreenToWorld2D
+	for _, test := range tests {
+		if actual := GetScreenToWorld2D(mouse, test.camera); !vector2NearlyEqual(actual, test.expected) {
+			t.Errorf("%s: GetScreenToWorld2D() = %v, want %v", test.name, actual, test.expected)
+		}
+	}
+
+	//Moving the mouse 40 pixels at double zoom moves 20 units in the world
+	camera := Camera2D{Offset: NewVector2(400, 300), Zoom: 2}
+	if delta := mouseWorldDelta(camera, mouse, NewVector2(460, 200)); !vector2NearlyEqual(delta, NewVector2(20, 0)) {
+		t.Errorf("mouseWorldDelta() = %v, want %v", delta, NewVector2(20, 0))
+	}
+}
+
+func TestFollowTarget(t *testing.T) {
+	target := NewVector2(100, -50)
+	camera := Camera2D{Zoom: 1}
+
+	previous := camera.Target.Distance(target)
+	steps := 0
+	for camera.Target != target && steps < 1000 {
+		camera.FollowTarget(target, 1.0/60, 5)
+		steps++
+
+		distance := camera.Target.Distance(target)
+		if distance >= previous {
+			t.Fatalf("step %d did not get closer, %v from the target", steps, distance)
+		}
+		previous = distance
+	}
+
+	if camera.Target != target {
+		t.Fatalf("FollowTarget() = %v after %d steps, want it to snap to %v", camera.Target, steps, target)
+	}
+
+	//The distance covered does not depend on the frame rate
+	a, b := Camera2D{Zoom: 1}, Camera2D{Zoom: 1}
+	a.FollowTarget(target, 0.1, 5)
+	for i := 0; i < 10; i++ {
+		b.FollowTarget(target, 0.01, 5)
+	}
+	if !vector2NearlyEqual(a.Target, b.Target) {
+		t.Errorf("FollowTarget() = %v with one step and %v with ten, want the same", a.Target, b.Target)
+	}
+
+	instant := Camera2D{Zoom: 1}
+	instant.FollowTarget(target, 1.0/60, 0)
+	if instant.Target != target {
+		t.Errorf("FollowTarget() with no smoothing = %v, want %v", instant.Target, target)
+	}
+}
@@ -0,0 +1,50 @@
+package raylib
+
+import "testing"
+
+//planeDistance is the signed distance of the point from the plane, positive inside
+func planeDistance(plane Vector4, point Vector3) float32 {
+	return point.X*plane.X + point.Y*plane.Y + point.Z*plane.Z + plane.W
+}
+
+func TestFrustumNearPlane(t *testing.T) {
+	tests := []struct {
+		name             string
+		position, target Vector3
+	}{
+		{"looking down -Z", NewVector3(0, 0, 10), NewVector3(0, 0, 0)},
+		{"looking down +X", NewVector3(-5, 2, 1), NewVector3(20, 2, 1)},
+		{"looking diagonally", NewVector3(3, 4, 5), NewVector3(-1, 0, 1)},
+	}
+
+	for _, test := range tests {
+		camera := NewCamera(test.position, test.target, NewVector3(0, 1, 0), 60, CameraTypePerspective)
+		planes := camera.GetFrustumPlanes(16.0 / 9)
+		forward := test.target.Subtract(test.position).Normalize()
+
+		//The near plane faces the way the camera looks, just in front of the camera
+		near := planes[FrustumNear]
+		normal := NewVector3(near.X, near.Y, near.Z)
+		if normal.Subtract(forward).Length() > 1e-3 {
+			t.Errorf("%s: near plane normal = %v, want the forward direction %v", test.name, normal, forward)
+		}
+		if distance := planeDistance(near, test.position); distance > -0.009 || distance < -0.011 {
+			t.Errorf("%s: camera is %v from the near plane, want just behind it at -0.01", test.name, distance)
+		}
+
+		far := planes[FrustumFar]
+		if normal := NewVector3(far.X, far.Y, far.Z); normal.Add(forward).Length() > 1e-3 {
+			t.Errorf("%s: far plane normal = %v, want facing back towards the camera", test.name, normal)
+		}
+
+		//The target is in view, while points behind the camera are not
+		for i, plane := range planes {
+			if planeDistance(plane, test.target) < 0 {
+				t.Errorf("%s: target is outside plane %d", test.name, i)
+			}
+		}
+		if behind := test.position.Subtract(forward); planeDistance(near, behind) >= 0 {
+			t.Errorf("%s: point behind the camera is inside the near plane", test.name)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package raylib
+
+import (
+	"math/rand"
+	"sort"
+)
+
+//CameraModifier changes the camera each frame, such as following a target or shaking.
+// Modifiers are given the camera as modified by all the modifiers before it, and return the camera for the next one.
+type CameraModifier interface {
+	Apply(camera Camera2D, dt float32) Camera2D
+}
+
+//CameraModifierFunc allows a function to be used as a CameraModifier
+type CameraModifierFunc func(camera Camera2D, dt float32) Camera2D
+
+//Apply calls the function
+func (fn CameraModifierFunc) Apply(camera Camera2D, dt float32) Camera2D { return fn(camera, dt) }
+
+//Orders the built in modifiers are usually added with. Follows move the target first, then zooms are applied, then shakes on top of the result.
+const (
+	CameraOrderFollow = 100
+	CameraOrderZoom   = 200
+	CameraOrderShake  = 300
+)
+
+//CameraRig combines several camera effects, such as following and shaking, that would otherwise fight over the camera.
+// Every frame the modifiers are applied to the base camera in order, lowest first. Modifiers with the same order are applied in the order they were added.
+type CameraRig struct {
+	//Base is the camera before any modifiers are applied
+	Base Camera2D
+
+	modifiers []rigModifier
+	camera    Camera2D
+	nextID    int
+}
+
+type rigModifier struct {
+	order    int
+	id       int
+	modifier CameraModifier
+}
+
+//NewCameraRig creates a rig with no modifiers around the camera
+func NewCameraRig(base Camera2D) *CameraRig {
+	return &CameraRig{Base: base, camera: base, modifiers: make([]rigModifier, 0)}
+}
+
+//Add adds a modifier to be applied at the given order. The returned id is used to remove it.
+func (rig *CameraRig) Add(order int, modifier CameraModifier) int {
+	rig.nextID++
+	rig.modifiers = append(rig.modifiers, rigModifier{order: order, id: rig.nextID, modifier: modifier})
+
+	//Ids only ever increase, so they also keep modifiers with the same order in the order they were added
+	sort.Slice(rig.modifiers, func(i, j int) bool {
+		if rig.modifiers[i].order != rig.modifiers[j].order {
+			return rig.modifiers[i].order < rig.modifiers[j].order
+		}
+		return rig.modifiers[i].id < rig.modifiers[j].id
+	})
+	return rig.nextID
+}
+
+//Remove removes the modifier with the id from the rig. Returns false if it was not in the rig.
+func (rig *CameraRig) Remove(id int) bool {
+	for i, m := range rig.modifiers {
+		if m.id == id {
+			rig.modifiers = append(rig.modifiers[:i], rig.modifiers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+//Update applies every modifier to the base camera. This should be called once per frame.
+func (rig *CameraRig) Update(dt float32) Camera2D {
+	camera := rig.Base
+	for _, m := range rig.modifiers {
+		camera = m.modifier.Apply(camera, dt)
+	}
+	rig.camera = camera
+	return camera
+}
+
+//Camera gets the camera from the last update. Use this with BeginMode2D.
+func (rig *CameraRig) Camera() Camera2D { return rig.camera }
+
+//FollowModifier moves the camera target smoothly towards a target, using Camera2D.FollowTarget
+type FollowModifier struct {
+	//Target is the world position to follow
+	Target Vector2
+	//Smoothing is how tightly the target is followed. 0 follows exactly.
+	Smoothing float32
+
+	current Vector2
+	started bool
+}
+
+//NewFollowModifier creates a modifier that starts on the target
+func NewFollowModifier(target Vector2, smoothing float32) *FollowModifier {
+	return &FollowModifier{Target: target, Smoothing: smoothing, current: target, started: true}
+}
+
+//Apply moves the target of the camera towards the followed target
+func (follow *FollowModifier) Apply(camera Camera2D, dt float32) Camera2D {
+	if !follow.started {
+		follow.current = follow.Target
+		follow.started = true
+	}
+
+	follower := Camera2D{Target: follow.current}
+	follower.FollowTarget(follow.Target, dt, follow.Smoothing)
+	follow.current = follower.Target
+
+	camera.Target = follow.current
+	return camera
+}
+
+//ZoomModifier smoothly changes the zoom of the camera towards a target zoom
+type ZoomModifier struct {
+	//Zoom is the zoom to move towards
+	Zoom float32
+	//Speed is the fraction of the remaining difference covered per second
+	Speed float32
+
+	current float32
+}
+
+//NewZoomModifier creates a modifier that starts at the zoom
+func NewZoomModifier(zoom, speed float32) *ZoomModifier {
+	return &ZoomModifier{Zoom: zoom, Speed: speed, current: zoom}
+}
+
+//Apply multiplies the zoom of the camera by the current zoom
+func (zoom *ZoomModifier) Apply(camera Camera2D, dt float32) Camera2D {
+	if zoom.current == 0 {
+		zoom.current = zoom.Zoom
+	}
+
+	zoom.current += (zoom.Zoom - zoom.current) * Clamp32(zoom.Speed*dt, 0, 1)
+	camera.Zoom *= zoom.current
+	return camera
+}
+
+//ShakeModifier shakes the camera by adding a random screen offset. The shake is driven by trauma, which decays over time.
+// The offset grows with the square of the trauma, so small knocks are subtle while big hits are violent.
+type ShakeModifier struct {
+	//MaxOffset is the largest offset in pixels at full trauma
+	MaxOffset Vector2
+	//MaxRotation is the largest rotation in degrees at full trauma
+	MaxRotation float32
+	//Decay is how much trauma is lost per second
+	Decay float32
+
+	trauma float32
+	random func() float32
+}
+
+//NewShakeModifier creates a modifier with no trauma
+func NewShakeModifier(maxOffset Vector2, maxRotation, decay float32) *ShakeModifier {
+	return &ShakeModifier{
+		MaxOffset:   maxOffset,
+		MaxRotation: maxRotation,
+		Decay:       decay,
+		random:      randomSigned,
+	}
+}
+
+//randomSigned is a random number between -1 and 1
+func randomSigned() float32 { return rand.Float32()*2 - 1 }
+
+//Shake adds trauma, up to a maximum of 1
+func (shake *ShakeModifier) Shake(trauma float32) {
+	shake.trauma = Clamp32(shake.trauma+trauma, 0, 1)
+}
+
+//Trauma is the current amount of trauma, between 0 and 1
+func (shake *ShakeModifier) Trauma() float32 { return shake.trauma }
+
+//Apply offsets the camera by a random amount, then decays the trauma
+func (shake *ShakeModifier) Apply(camera Camera2D, dt float32) Camera2D {
+	if shake.random == nil {
+		shake.random = randomSigned
+	}
+
+	if shake.trauma > 0 {
+		amount := shake.trauma * shake.trauma
+		camera.Offset = camera.Offset.Add(NewVector2(shake.MaxOffset.X*amount*shake.random(), shake.MaxOffset.Y*amount*shake.random()))
+		camera.Rotation += shake.MaxRotation * amount * shake.random()
+	}
+
+	shake.trauma = Clamp32(shake.trauma-shake.Decay*dt, 0, 1)
+	return camera
+}
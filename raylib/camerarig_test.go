@@ -0,0 +1,99 @@
+package raylib
+
+import "testing"
+
+//sequenceRandom returns the values in order, repeating them once they run out
+func sequenceRandom(values ...float32) func() float32 {
+	i := 0
+	return func() float32 {
+		value := values[i%len(values)]
+		i++
+		return value
+	}
+}
+
+func TestCameraRigFollowAndShake(t *testing.T) {
+	base := Camera2D{Offset: NewVector2(400, 300), Zoom: 1}
+	rig := NewCameraRig(base)
+
+	follow := NewFollowModifier(NewVector2(50, 60), 0)
+	shake := NewShakeModifier(NewVector2(40, 20), 8, 1)
+	shake.random = sequenceRandom(1, -0.5, 0.25)
+
+	//Added out of order, as the rig sorts them
+	rig.Add(CameraOrderShake, shake)
+	rig.Add(CameraOrderFollow, follow)
+
+	shake.Shake(0.5)
+	camera := rig.Update(0.1)
+
+	//Trauma of 0.5 gives a quarter of the maximum shake
+	if !vector2NearlyEqual(camera.Target, NewVector2(50, 60)) {
+		t.Errorf("target = %v, want the followed target", camera.Target)
+	}
+	if !vector2NearlyEqual(camera.Offset, NewVector2(410, 297.5)) {
+		t.Errorf("offset = %v, want %v", camera.Offset, NewVector2(410, 297.5))
+	}
+	if !nearlyEqual(camera.Rotation, 0.5) {
+		t.Errorf("rotation = %v, want 0.5", camera.Rotation)
+	}
+	if !nearlyEqual(shake.Trauma(), 0.4) {
+		t.Errorf("Trauma() = %v, want 0.4 after decaying", shake.Trauma())
+	}
+
+	//The base camera is not changed, so the shake does not build up
+	follow.Target = NewVector2(-10, 5)
+	camera = rig.Update(0.1)
+	if !vector2NearlyEqual(camera.Target, NewVector2(-10, 5)) {
+		t.Errorf("target after moving = %v, want %v", camera.Target, NewVector2(-10, 5))
+	}
+	if expected := NewVector2(400+40*0.16, 300+20*0.16*-0.5); !vector2NearlyEqual(camera.Offset, expected) {
+		t.Errorf("offset on the second frame = %v, want %v", camera.Offset, expected)
+	}
+	if rig.Base != base || rig.Camera() != camera {
+		t.Errorf("Base = %v and Camera() = %v, want the base unchanged and the last update", rig.Base, rig.Camera())
+	}
+
+	//Once the trauma has gone, only the follow is left
+	for i := 0; i < 10; i++ {
+		camera = rig.Update(0.1)
+	}
+	if camera.Offset != base.Offset || camera.Rotation != 0 {
+		t.Errorf("camera after the trauma decayed = %v, want no shake", camera)
+	}
+}
+
+func TestCameraRigRemove(t *testing.T) {
+	rig := NewCameraRig(Camera2D{Zoom: 1})
+
+	//Functions are not comparable, so the rig has to remove them by id
+	double := rig.Add(CameraOrderZoom, CameraModifierFunc(func(camera Camera2D, dt float32) Camera2D {
+		camera.Zoom *= 2
+		return camera
+	}))
+	triple := rig.Add(CameraOrderZoom, CameraModifierFunc(func(camera Camera2D, dt float32) Camera2D {
+		camera.Zoom *= 3
+		return camera
+	}))
+
+	if zoom := rig.Update(0.1).Zoom; zoom != 6 {
+		t.Fatalf("zoom with both modifiers = %v, want 6", zoom)
+	}
+
+	if !rig.Remove(double) {
+		t.Errorf("Remove(%d) = false, want true", double)
+	}
+	if zoom := rig.Update(0.1).Zoom; zoom != 3 {
+		t.Errorf("zoom after removing the doubling = %v, want 3", zoom)
+	}
+
+	if rig.Remove(double) {
+		t.Errorf("removing %d twice = true, want false", double)
+	}
+	if !rig.Remove(triple) {
+		t.Errorf("Remove(%d) = false, want true", triple)
+	}
+	if zoom := rig.Update(0.1).Zoom; zoom != 1 {
+		t.Errorf("zoom with no modifiers = %v, want 1", zoom)
+	}
+}
@@ -0,0 +1,115 @@
+package raylib
+
+//VirtualCanvas is a fixed resolution render target that is scaled and letterboxed to fit the window.
+// This allows the game and UI to be designed for a single reference resolution.
+type VirtualCanvas struct {
+	//Width is the reference width of the canvas
+	Width int
+	//Height is the reference height of the canvas
+	Height int
+	//Target is the render texture the canvas is drawn into
+	Target RenderTexture2D
+	//Letterbox is the colour of the bars around the canvas
+	Letterbox Color
+
+	scale        float32
+	offset       Vector2
+	screenWidth  int
+	screenHeight int
+}
+
+//LoadVirtualCanvas creates a new canvas with a reference resolution
+func LoadVirtualCanvas(width, height int) *VirtualCanvas {
+	canvas := &VirtualCanvas{
+		Width:     width,
+		Height:    height,
+		Target:    LoadRenderTexture(width, height),
+		Letterbox: Black,
+		scale:     1,
+	}
+
+	//The canvas unloads its render texture, so it must not be unloaded again by UnloadAll
+	UnregisterUnloadable(canvas.Target)
+	RegisterUnloadable(canvas)
+	return canvas
+}
+
+//Unload unloads the render texture of the canvas
+func (canvas *VirtualCanvas) Unload() {
+	canvas.Target.Unload()
+	UnregisterUnloadable(canvas)
+}
+
+//Resize recalculates the scaling and letterboxing for a screen size.
+// This is automatically called by Begin when the screen size changes.
+func (canvas *VirtualCanvas) Resize(screenWidth, screenHeight int) {
+	canvas.screenWidth = screenWidth
+	canvas.screenHeight = screenHeight
+
+	if canvas.Width <= 0 || canvas.Height <= 0 {
+		canvas.scale = 1
+		canvas.offset = NewVector2Zero()
+		return
+	}
+
+	scaleX := float32(screenWidth) / float32(canvas.Width)
+	scaleY := float32(screenHeight) / float32(canvas.Height)
+	canvas.scale = scaleX
+	if scaleY < scaleX {
+		canvas.scale = scaleY
+	}
+
+	canvas.offset = NewVector2(
+		(float32(screenWidth)-float32(canvas.Width)*canvas.scale)/2,
+		(float32(screenHeight)-float32(canvas.Height)*canvas.scale)/2,
+	)
+}
+
+//Scale is the current scale from the canvas to the screen
+func (canvas *VirtualCanvas) Scale() float32 { return canvas.scale }
+
+//Bounds is the area on the screen that the canvas is drawn to
+func (canvas *VirtualCanvas) Bounds() Rectangle {
+	return NewRectangle(canvas.offset.X, canvas.offset.Y, float32(canvas.Width)*canvas.scale, float32(canvas.Height)*canvas.scale)
+}
+
+//ToVirtual converts a screen position (such as the mouse) into a position on the canvas.
+// If the canvas has no size on the screen, such as while the window is minimised, the position is only offset and not scaled.
+func (canvas *VirtualCanvas) ToVirtual(screen Vector2) Vector2 {
+	if canvas.scale <= 0 {
+		return screen.Subtract(canvas.offset)
+	}
+	return screen.Subtract(canvas.offset).Divide(canvas.scale)
+}
+
+//ToScreen converts a position on the canvas into a position on the screen
+func (canvas *VirtualCanvas) ToScreen(virtual Vector2) Vector2 {
+	return virtual.Scale(canvas.scale).Add(canvas.offset)
+}
+
+//GetMousePosition gets the mouse position on the canvas
+func (canvas *VirtualCanvas) GetMousePosition() Vector2 {
+	return canvas.ToVirtual(GetMousePosition())
+}
+
+//Begin starts drawing to the canvas, updating the scaling if the window has been resized.
+func (canvas *VirtualCanvas) Begin() {
+	if width, height := GetScreenWidth(), GetScreenHeight(); width != canvas.screenWidth || height != canvas.screenHeight {
+		canvas.Resize(width, height)
+	}
+	BeginTextureMode(canvas.Target)
+}
+
+//End stops drawing to the canvas
+func (canvas *VirtualCanvas) End() {
+	EndTextureMode()
+}
+
+//Draw clears the screen with the letterbox colour and draws the scaled canvas. Call this between BeginDrawing and EndDrawing.
+func (canvas *VirtualCanvas) Draw() {
+	ClearBackground(canvas.Letterbox)
+
+	//Render textures are upside down, so flip the source
+	source := NewRectangle(0, 0, float32(canvas.Target.Texture.Width), -float32(canvas.Target.Texture.Height))
+	DrawTexturePro(canvas.Target.Texture, source, canvas.Bounds(), NewVector2Zero(), 0, White)
+}
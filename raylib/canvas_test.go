@@ -0,0 +1,46 @@
+package raylib
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVirtualCanvasToVirtual(t *testing.T) {
+	tests := []struct {
+		name                      string
+		screenWidth, screenHeight int
+		screen, virtual           Vector2
+	}{
+		{"same size", 320, 180, NewVector2(100, 50), NewVector2(100, 50)},
+		{"double size", 640, 360, NewVector2(200, 100), NewVector2(100, 50)},
+		{"pillarboxed", 800, 360, NewVector2(80+200, 100), NewVector2(100, 50)},
+		{"letterboxed", 640, 400, NewVector2(200, 20+100), NewVector2(100, 50)},
+		{"in the bars", 800, 360, NewVector2(40, 0), NewVector2(-20, 0)},
+	}
+
+	for _, test := range tests {
+		canvas := &VirtualCanvas{Width: 320, Height: 180}
+		canvas.Resize(test.screenWidth, test.screenHeight)
+
+		if got := canvas.ToVirtual(test.screen); !vector2NearlyEqual(got, test.virtual) {
+			t.Errorf("%s: ToVirtual(%v) = %v, want %v", test.name, test.screen, got, test.virtual)
+		}
+		if got := canvas.ToScreen(test.virtual); !vector2NearlyEqual(got, test.screen) {
+			t.Errorf("%s: ToScreen(%v) = %v, want %v", test.name, test.virtual, got, test.screen)
+		}
+	}
+}
+
+func TestVirtualCanvasToVirtualZeroScale(t *testing.T) {
+	//A minimised window has no size, and a canvas that has not been resized has no scale
+	minimised := &VirtualCanvas{Width: 320, Height: 180}
+	minimised.Resize(0, 0)
+	unsized := &VirtualCanvas{Width: 320, Height: 180}
+
+	for name, canvas := range map[string]*VirtualCanvas{"minimised": minimised, "unsized": unsized} {
+		got := canvas.ToVirtual(NewVector2(10, 20))
+		if x, y := float64(got.X), float64(got.Y); math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+			t.Errorf("%s: ToVirtual() = %v, want a finite position", name, got)
+		}
+	}
+}
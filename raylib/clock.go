@@ -0,0 +1,70 @@
+package raylib
+
+//Clock is a controllable game clock built on GetTime that can be paused and scaled.
+// Call Update once per frame, then feed Delta into your game's update. This allows for pause menus and bullet-time effects.
+type Clock struct {
+	scale    float32
+	paused   bool
+	now      float32
+	delta    float32
+	lastTime float64
+	source   func() float64
+}
+
+//NewClock creates a new clock starting at zero, with a scale of 1
+func NewClock() *Clock {
+	return newClockWithSource(GetTime)
+}
+
+//newClockWithSource creates a clock that reads its real time from the source
+func newClockWithSource(source func() float64) *Clock {
+	return &Clock{scale: 1, source: source, lastTime: source()}
+}
+
+//Update samples the real time and advances the clock. This should be called once per frame.
+// While paused, the clock does not advance and the delta will be zero.
+func (c *Clock) Update() {
+	current := c.source()
+	elapsed := float32(current - c.lastTime)
+	c.lastTime = current
+
+	if c.paused {
+		c.delta = 0
+		return
+	}
+
+	c.delta = elapsed * c.scale
+	c.now += c.delta
+}
+
+//Now is the scaled time in seconds that has passed on the clock, excluding any time spent paused
+func (c *Clock) Now() float32 { return c.now }
+
+//Delta is the scaled time in seconds between the last two updates
+func (c *Clock) Delta() float32 { return c.delta }
+
+//Pause stops the clock from advancing
+func (c *Clock) Pause() { c.paused = true }
+
+//Resume lets the clock continue advancing. Time spent paused is not counted, even if Update was not called while paused.
+func (c *Clock) Resume() {
+	if c.paused {
+		c.lastTime = c.source()
+	}
+	c.paused = false
+}
+
+//IsPaused returns true if the clock is paused
+func (c *Clock) IsPaused() bool { return c.paused }
+
+//SetScale sets how fast the clock runs compared to real time. 0.5 is half speed and 2 is double.
+// Negative scales are treated as zero.
+func (c *Clock) SetScale(scale float32) {
+	if scale < 0 {
+		scale = 0
+	}
+	c.scale = scale
+}
+
+//Scale is how fast the clock runs compared to real time
+func (c *Clock) Scale() float32 { return c.scale }
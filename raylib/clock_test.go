@@ -0,0 +1,98 @@
+package raylib
+
+import "testing"
+
+//fakeTime is a time source for tests that only moves when told to
+type fakeTime struct {
+	now float64
+}
+
+func (f *fakeTime) get() float64            { return f.now }
+func (f *fakeTime) advance(seconds float64) { f.now += seconds }
+
+func TestClockPause(t *testing.T) {
+	source := &fakeTime{now: 100}
+	clock := newClockWithSource(source.get)
+
+	source.advance(1)
+	clock.Update()
+	if !nearlyEqual(clock.Now(), 1) || !nearlyEqual(clock.Delta(), 1) {
+		t.Errorf("Now() = %v, Delta() = %v, want 1 and 1", clock.Now(), clock.Delta())
+	}
+
+	clock.Pause()
+	source.advance(5)
+	clock.Update()
+	if !clock.IsPaused() || !nearlyEqual(clock.Now(), 1) || clock.Delta() != 0 {
+		t.Errorf("while paused Now() = %v, Delta() = %v, want 1 and 0", clock.Now(), clock.Delta())
+	}
+
+	//Time spent paused is not counted once resumed
+	clock.Resume()
+	source.advance(0.5)
+	clock.Update()
+	if clock.IsPaused() || !nearlyEqual(clock.Now(), 1.5) || !nearlyEqual(clock.Delta(), 0.5) {
+		t.Errorf("after Resume() Now() = %v, Delta() = %v, want 1.5 and 0.5", clock.Now(), clock.Delta())
+	}
+}
+
+func TestClockResumeWithoutUpdate(t *testing.T) {
+	source := &fakeTime{now: 100}
+	clock := newClockWithSource(source.get)
+
+	source.advance(1)
+	clock.Update()
+
+	//Nothing updates the clock while it is paused, such as when a pause menu skips the game's update
+	clock.Pause()
+	source.advance(5)
+	clock.Resume()
+
+	source.advance(0.5)
+	clock.Update()
+	if !nearlyEqual(clock.Now(), 1.5) || !nearlyEqual(clock.Delta(), 0.5) {
+		t.Errorf("after Resume() Now() = %v, Delta() = %v, want 1.5 and 0.5", clock.Now(), clock.Delta())
+	}
+
+	//Resuming a clock that is running does not lose time
+	source.advance(0.25)
+	clock.Resume()
+	clock.Update()
+	if !nearlyEqual(clock.Now(), 1.75) || !nearlyEqual(clock.Delta(), 0.25) {
+		t.Errorf("after resuming a running clock Now() = %v, Delta() = %v, want 1.75 and 0.25", clock.Now(), clock.Delta())
+	}
+}
+
+func TestClockScale(t *testing.T) {
+	source := &fakeTime{}
+	clock := newClockWithSource(source.get)
+
+	tests := []struct {
+		scale    float32
+		expected float32
+	}{
+		{2, 2},
+		{0.5, 0.5},
+		{0, 0},
+		{-1, 0},
+	}
+
+	var now float32
+	for _, test := range tests {
+		clock.SetScale(test.scale)
+		source.advance(1)
+		clock.Update()
+		now += test.expected
+
+		if !nearlyEqual(clock.Delta(), test.expected) {
+			t.Errorf("Delta() with scale %v = %v, want %v", test.scale, clock.Delta(), test.expected)
+		}
+		if !nearlyEqual(clock.Now(), now) {
+			t.Errorf("Now() with scale %v = %v, want %v", test.scale, clock.Now(), now)
+		}
+	}
+
+	if clock.Scale() != 0 {
+		t.Errorf("Scale() after setting a negative scale = %v, want 0", clock.Scale())
+	}
+}
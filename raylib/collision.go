@@ -0,0 +1,114 @@
+package raylib
+
+import "math"
+
+//SweepCircleRect performs a continuous collision check of a moving circle against a static rectangle.
+// The circle starts at center and moves by the entire velocity over the step.
+// Returns the fraction of the velocity [0..1] travelled before impact and the surface normal at the point of impact.
+// If the circle is already overlapping the rectangle, t will be 0.
+func SweepCircleRect(center Vector2, radius float32, velocity Vector2, rect Rectangle) (t float32, normal Vector2, hit bool) {
+
+	//Already overlapping, so we hit at the very start. Push out from the closest point.
+	closest := NewVector2(Clamp32(center.X, rect.X, rect.X+rect.Width), Clamp32(center.Y, rect.Y, rect.Y+rect.Height))
+	offset := center.Subtract(closest)
+	if offset.SqrLength() <= radius*radius {
+		if offset.SqrLength() > 0 {
+			normal = offset.Normalize()
+		}
+		return 0, normal, true
+	}
+
+	//Slab test the ray against the rectangle expanded by the radius
+	tEnter := Inf(-1)
+	tExit := Inf(1)
+	axes := [2]struct{ position, velocity, min, max float32 }{
+		{center.X, velocity.X, rect.X - radius, rect.X + rect.Width + radius},
+		{center.Y, velocity.Y, rect.Y - radius, rect.Y + rect.Height + radius},
+	}
+
+	for i, axis := range axes {
+		if axis.velocity == 0 {
+			if axis.position < axis.min || axis.position > axis.max {
+				return 0, Vector2{}, false
+			}
+			continue
+		}
+
+		near := (axis.min - axis.position) / axis.velocity
+		far := (axis.max - axis.position) / axis.velocity
+		sign := float32(-1)
+		if near > far {
+			near, far = far, near
+			sign = 1
+		}
+
+		if near > tEnter {
+			tEnter = near
+			normal = Vector2{}
+			if i == 0 {
+				normal.X = sign
+			} else {
+				normal.Y = sign
+			}
+		}
+		if far < tExit {
+			tExit = far
+		}
+	}
+
+	if tEnter > tExit || tExit < 0 || tEnter > 1 {
+		return 0, Vector2{}, false
+	}
+
+	//Work out where we would enter the expanded rectangle
+	point := center
+	if tEnter > 0 {
+		point = center.Add(velocity.Scale(tEnter))
+	}
+
+	//If we are beside a corner, the expanded rectangle is actually rounded,
+	// so we have to check against the circle around that corner instead.
+	outsideX := point.X < rect.X || point.X > rect.X+rect.Width
+	outsideY := point.Y < rect.Y || point.Y > rect.Y+rect.Height
+	if outsideX && outsideY {
+		corner := NewVector2(rect.X, rect.Y)
+		if point.X > rect.X+rect.Width {
+			corner.X = rect.X + rect.Width
+		}
+		if point.Y > rect.Y+rect.Height {
+			corner.Y = rect.Y + rect.Height
+		}
+
+		ct, ok := sweepPointCircle(center, velocity, corner, radius)
+		if !ok {
+			return 0, Vector2{}, false
+		}
+
+		normal = center.Add(velocity.Scale(ct)).Subtract(corner).Divide(radius)
+		return ct, normal, true
+	}
+
+	return tEnter, normal, true
+}
+
+//sweepPointCircle finds the first fraction [0..1] of the velocity where the moving point touches the circle.
+func sweepPointCircle(point, velocity, center Vector2, radius float32) (float32, bool) {
+	m := point.Subtract(center)
+	a := float64(velocity.DotProduct(velocity))
+	b := float64(m.DotProduct(velocity))
+	c := float64(m.DotProduct(m) - radius*radius)
+	if a == 0 {
+		return 0, false
+	}
+
+	discriminant := b*b - a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	t := (-b - math.Sqrt(discriminant)) / a
+	if t < 0 || t > 1 {
+		return 0, false
+	}
+	return float32(t), true
+}
@@ -0,0 +1,64 @@
+package raylib
+
+import "testing"
+
+func TestSweepCircleRect(t *testing.T) {
+	rect := NewRectangle(10, 10, 10, 10)
+
+	tests := []struct {
+		name     string
+		center   Vector2
+		velocity Vector2
+		hit      bool
+		t        float32
+		normal   Vector2
+	}{
+		{"head on from the left", NewVector2(0, 15), NewVector2(20, 0), true, 0.45, NewVector2(-1, 0)},
+		{"head on from below", NewVector2(15, 30), NewVector2(0, -20), true, 0.45, NewVector2(0, 1)},
+		{"stops short", NewVector2(0, 15), NewVector2(5, 0), false, 0, Vector2{}},
+		{"moving away", NewVector2(0, 15), NewVector2(-20, 0), false, 0, Vector2{}},
+		{"grazing past the top", NewVector2(0, 8.5), NewVector2(30, 0), false, 0, Vector2{}},
+		{"grazing past the corner", NewVector2(5, 13.4), NewVector2(8, -8), false, 0, Vector2{}},
+		{"already overlapping", NewVector2(9.5, 15), NewVector2(-20, 0), true, 0, NewVector2(-1, 0)},
+	}
+
+	for _, test := range tests {
+		tHit, normal, hit := SweepCircleRect(test.center, 1, test.velocity, rect)
+		if hit != test.hit {
+			t.Errorf("%s: hit = %v, want %v", test.name, hit, test.hit)
+			continue
+		}
+		if !hit {
+			continue
+		}
+
+		if !nearlyEqual(tHit, test.t) {
+			t.Errorf("%s: t = %v, want %v", test.name, tHit, test.t)
+		}
+		if !vector2NearlyEqual(normal, test.normal) {
+			t.Errorf("%s: normal = %v, want %v", test.name, normal, test.normal)
+		}
+	}
+}
+
+func TestSweepCircleRectCorner(t *testing.T) {
+	rect := NewRectangle(10, 10, 10, 10)
+
+	//Passes within the radius of the top left corner, so the normal points out of the corner
+	tHit, normal, hit := SweepCircleRect(NewVector2(5, 13.8), 1, NewVector2(8, -8), rect)
+	if !hit {
+		t.Fatal("SweepCircleRect() clipping the corner did not hit")
+	}
+	if tHit <= 0 || tHit >= 1 {
+		t.Errorf("t = %v, want part way along the velocity", tHit)
+	}
+	if normal.X >= 0 || normal.Y >= 0 || !nearlyEqual(normal.Length(), 1) {
+		t.Errorf("normal = %v, want a unit normal out of the top left corner", normal)
+	}
+
+	//The circle should just touch the corner where it hit
+	contact := NewVector2(5, 13.8).Add(NewVector2(8, -8).Scale(tHit))
+	if distance := contact.Distance(NewVector2(10, 10)); !nearlyEqual(distance, 1) {
+		t.Errorf("distance from the corner at impact = %v, want the radius", distance)
+	}
+}
@@ -0,0 +1,128 @@
+package raylib
+
+import "math"
+
+//CollisionMask is a bitmap of the solid pixels of an image, used for pixel perfect collision
+type CollisionMask struct {
+	Width  int
+	Height int
+
+	stride int      //Number of words in a row
+	bits   []uint64 //Packed rows of bits, where a set bit is solid
+}
+
+//NewCollisionMask creates a mask from the image. Pixels with an alpha above the threshold are solid.
+func NewCollisionMask(image *Image, alphaThreshold uint8) *CollisionMask {
+	width, height := int(image.Width), int(image.Height)
+	mask := newEmptyCollisionMask(width, height)
+
+	pixels := image.GetPixels()
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if pixels[x+y*width].A > alphaThreshold {
+				mask.Set(x, y, true)
+			}
+		}
+	}
+
+	return mask
+}
+
+//newEmptyCollisionMask creates a mask with no solid pixels
+func newEmptyCollisionMask(width, height int) *CollisionMask {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+
+	stride := (width + 63) / 64
+	return &CollisionMask{
+		Width:  width,
+		Height: height,
+		stride: stride,
+		bits:   make([]uint64, stride*height),
+	}
+}
+
+//Get checks if the pixel is solid. Pixels outside of the mask are never solid.
+func (m *CollisionMask) Get(x, y int) bool {
+	if x < 0 || y < 0 || x >= m.Width || y >= m.Height {
+		return false
+	}
+	return m.bits[y*m.stride+x/64]&(1<<uint(x%64)) != 0
+}
+
+//Set marks the pixel as solid or empty
+func (m *CollisionMask) Set(x, y int, solid bool) {
+	if x < 0 || y < 0 || x >= m.Width || y >= m.Height {
+		return
+	}
+
+	if solid {
+		m.bits[y*m.stride+x/64] |= 1 << uint(x%64)
+	} else {
+		m.bits[y*m.stride+x/64] &^= 1 << uint(x%64)
+	}
+}
+
+//Overlaps checks if any solid pixels of the two masks overlap, where the offset is the position of the other mask relative to this one.
+// The offset is rounded to the nearest pixel.
+func (m *CollisionMask) Overlaps(other *CollisionMask, offset Vector2) bool {
+	ox := int(math.Round(float64(offset.X)))
+	oy := int(math.Round(float64(offset.Y)))
+
+	//Rows of this mask that the other mask covers
+	minY, maxY := oy, oy+other.Height
+	if minY < 0 {
+		minY = 0
+	}
+	if maxY > m.Height {
+		maxY = m.Height
+	}
+
+	//Words of this mask that the other mask covers
+	minWord, maxWord := 0, m.stride
+	if ox > 0 {
+		minWord = ox / 64
+	}
+	if end := (ox + other.Width + 63) / 64; end < maxWord {
+		maxWord = end
+	}
+
+	for y := minY; y < maxY; y++ {
+		row := m.bits[y*m.stride : (y+1)*m.stride]
+		for w := minWord; w < maxWord; w++ {
+			if row[w] != 0 && row[w]&other.rowBits(y-oy, w*64-ox) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+//OverlapsAt checks if the masks overlap when this mask is at position and the other mask is at otherPosition
+func (m *CollisionMask) OverlapsAt(position Vector2, other *CollisionMask, otherPosition Vector2) bool {
+	return m.Overlaps(other, otherPosition.Subtract(position))
+}
+
+//rowBits gets the 64 bits of a row starting from the x, which may be unaligned or outside of the mask
+func (m *CollisionMask) rowBits(y, x int) uint64 {
+	if y < 0 || y >= m.Height || x >= m.Width || x <= -64 || m.stride == 0 {
+		return 0
+	}
+
+	row := m.bits[y*m.stride : (y+1)*m.stride]
+	if x < 0 {
+		return row[0] << uint(-x)
+	}
+
+	word, shift := x/64, uint(x%64)
+	bits := row[word] >> shift
+	if shift != 0 && word+1 < len(row) {
+		bits |= row[word+1] << (64 - shift)
+	}
+	return bits
+}
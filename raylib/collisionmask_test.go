@@ -0,0 +1,104 @@
+package raylib
+
+import "testing"
+
+//testMask creates a mask with a solid diagonal pattern, so different offsets overlap in different places
+func testMask(width, height, seed int) *CollisionMask {
+	mask := newEmptyCollisionMask(width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if (x*7+y*13+seed)%11 == 0 {
+				mask.Set(x, y, true)
+			}
+		}
+	}
+	return mask
+}
+
+//naiveOverlaps checks every pixel of the masks to see if any overlap
+func naiveOverlaps(a, b *CollisionMask, ox, oy int) bool {
+	for y := 0; y < b.Height; y++ {
+		for x := 0; x < b.Width; x++ {
+			if b.Get(x, y) && a.Get(x+ox, y+oy) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestCollisionMaskOverlapsPixels(t *testing.T) {
+	a := newEmptyCollisionMask(4, 4)
+	a.Set(1, 1, true)
+	b := newEmptyCollisionMask(2, 2)
+	b.Set(0, 0, true)
+
+	tests := []struct {
+		offset   Vector2
+		expected bool
+	}{
+		{NewVector2(1, 1), true},
+		{NewVector2(0, 0), false},
+		{NewVector2(2, 1), false},
+		{NewVector2(1.4, 0.6), true},
+		{NewVector2(1.6, 1), false},
+		{NewVector2(-1, -1), false},
+		{NewVector2(10, 10), false},
+	}
+
+	for _, test := range tests {
+		if actual := a.Overlaps(b, test.offset); actual != test.expected {
+			t.Errorf("Overlaps() at %v = %v, want %v", test.offset, actual, test.expected)
+		}
+	}
+
+	if !a.OverlapsAt(NewVector2(10, 20), b, NewVector2(11, 21)) {
+		t.Error("OverlapsAt() with the pixels in the same place = false, want true")
+	}
+}
+
+func TestCollisionMaskOverlapsOffsets(t *testing.T) {
+	//Wider than a word, so the unaligned offsets have to combine bits from neighbouring words
+	a := testMask(150, 20, 0)
+	b := testMask(70, 12, 5)
+
+	for oy := -14; oy <= 22; oy += 3 {
+		for ox := -75; ox <= 155; ox++ {
+			expected := naiveOverlaps(a, b, ox, oy)
+			if actual := a.Overlaps(b, NewVector2(float32(ox), float32(oy))); actual != expected {
+				t.Errorf("Overlaps() at (%d, %d) = %v, want %v", ox, oy, actual, expected)
+			}
+		}
+	}
+}
+
+func TestCollisionMaskEmpty(t *testing.T) {
+	a := newEmptyCollisionMask(100, 100)
+	b := testMask(30, 30, 0)
+
+	if a.Overlaps(b, Vector2{}) || b.Overlaps(a, Vector2{}) {
+		t.Error("Overlaps() with an empty mask = true, want false")
+	}
+
+	empty := newEmptyCollisionMask(-5, 0)
+	if empty.Width != 0 || empty.Height != 0 || b.Overlaps(empty, Vector2{}) {
+		t.Errorf("newEmptyCollisionMask() with negative size = %dx%d, want an empty mask", empty.Width, empty.Height)
+	}
+}
+
+func TestNewCollisionMask(t *testing.T) {
+	pixels := []Color{
+		NewColor(255, 255, 255, 0), NewColor(255, 255, 255, 128),
+		NewColor(255, 255, 255, 129), NewColor(255, 255, 255, 255),
+	}
+	image := LoadImageEx(pixels, 2, 2)
+	defer image.Unload()
+
+	mask := NewCollisionMask(image, 128)
+	expected := []bool{false, false, true, true}
+	for i, solid := range expected {
+		if actual := mask.Get(i%2, i/2); actual != solid {
+			t.Errorf("pixel %d with alpha %d is solid = %v, want %v", i, pixels[i].A, actual, solid)
+		}
+	}
+}
@@ -79,45 +79,18 @@ func newColorFromPointer(ptr unsafe.Pointer) Color { return *(*Color)(ptr) }
 //NewColor creates a new colour
 func NewColor(r, g, b, a uint8) Color { return Color{R: r, G: g, B: b, A: a} }
 
-//NewColorFromHSV turns a HSV to a colour
+//NewColorFromHSV turns a HSV to a colour. The hue is in degrees, and the saturation and value are between 0 and 1. The colour is opaque.
 func NewColorFromHSV(hsv Vector3) Color {
+	color := ColorFromHSV(hsv)
+	color.A = 255
+	return color
+}
 
-	h, s, v := float64(hsv.X), float64(hsv.Y), float64(hsv.Z)
-	var k, t, r, g, b float64
-
-	//RED
-	k = math.Mod((5 + h/60), 6)
-	t = 4 - k
-	if t < k {
-		k = t
-	}
-	k = Clamp(k, 0, 1)
-	r = (v - v*s*k) * 255
-
-	//Green
-	k = math.Mod((3 + h/60), 6)
-	t = 4 - k
-	if t < k {
-		k = t
-	}
-	k = Clamp(k, 0, 1)
-	g = (v - v*s*k) * 255
-
-	//Blue
-	k = math.Mod((1 + h/60), 6)
-	t = 4 - k
-	if t < k {
-		k = t
-	}
-	k = Clamp(k, 0, 1)
-	b = (v - v*s*k) * 255
-
-	return Color{
-		R: uint8(r),
-		G: uint8(g),
-		B: uint8(b),
-		A: 255,
-	}
+//NewColorFromHSVA turns a HSV with an alpha in W between 0 and 1 into a colour
+func NewColorFromHSVA(hsva Vector4) Color {
+	color := ColorFromHSV(NewVector3(hsva.X, hsva.Y, hsva.Z))
+	color.A = uint8(255*Clamp32(hsva.W, 0, 1) + 0.5)
+	return color
 }
 
 //NewColorFromNormalized creates a colour from the normalized value [0..1]
@@ -150,43 +123,16 @@ func (c Color) Normalize() Vector4 {
 	return NewVector4(float32(c.R)/255.0, float32(c.G)/255.0, float32(c.B)/255.0, float32(c.A)/255.0)
 }
 
+//ToHSV converts the colour into HSV. The hue is in degrees, and the saturation and value are between 0 and 1.
+// Grays have no saturation and a hue of 0.
 func (c Color) ToHSV() Vector3 {
-	r, g, b := float64(c.R)/255.0, float64(c.G)/255.0, float64(c.B)/255.0
-	hsv := &Vector3{X: 0, Y: 0, Z: 0}
-
-	min := math.Min(math.Min(r, g), b)
-	max := math.Max(math.Max(r, g), b)
-
-	delta := max - min
-	hsv.Z = float32(max)
-	if delta < 0.00001 {
-		return *hsv
-	}
-
-	if max > 0 {
-		hsv.Y = float32(delta / max)
-	} else {
-		hsv.X = NaN32()
-		return *hsv
-	}
-
-	if r >= max {
-		hsv.X = float32((g - b) / delta)
-	} else {
-		if g >= max {
-			hsv.X = 2 + float32((b - r/delta))
-		} else {
-			hsv.X = 4 + float32((r - g/delta))
-		}
-	}
-
-	//Convert to degrees
-	hsv.X *= 60
-	if hsv.X < 0 {
-		hsv.X += 360
-	}
+	return ColorToHSV(c)
+}
 
-	return *hsv
+//ToHSVA converts the colour into HSV with the alpha between 0 and 1 in W, so it survives a round trip through NewColorFromHSVA
+func (c Color) ToHSVA() Vector4 {
+	hsv := c.ToHSV()
+	return NewVector4(hsv.X, hsv.Y, hsv.Z, float32(c.A)/255)
 }
 
 //Fade a colour
@@ -194,9 +140,28 @@ func (c Color) Fade(alpha float32) Color {
 	return Color{R: c.R, B: c.B, G: c.G, A: uint8(255 * Clamp32(alpha, 0, 1))}
 }
 
-//Lerp a color towards another color
+//Lerp a color towards another color. The amount is clamped between 0 and 1, and every channel including the alpha is interpolated.
 func (c Color) Lerp(target Color, amount float32) Color {
-	return NewColorFromNormalized(c.Normalize().Lerp(target.Normalize(), amount))
+	amount = Clamp32(amount, 0, 1)
+	lerp := func(from, to uint8) uint8 {
+		return uint8(float32(from) + (float32(to)-float32(from))*amount + 0.5)
+	}
+	return Color{R: lerp(c.R, target.R), G: lerp(c.G, target.G), B: lerp(c.B, target.B), A: lerp(c.A, target.A)}
+}
+
+//AlphaBlend draws the other colour over this one using its alpha, the same as source-over compositing
+func (c Color) AlphaBlend(over Color) Color {
+	srcAlpha := float32(over.A) / 255
+	dstAlpha := float32(c.A) / 255
+	outAlpha := srcAlpha + dstAlpha*(1-srcAlpha)
+	if outAlpha <= 0 {
+		return Color{}
+	}
+
+	blend := func(src, dst uint8) uint8 {
+		return uint8((float32(src)*srcAlpha+float32(dst)*dstAlpha*(1-srcAlpha))/outAlpha + 0.5)
+	}
+	return Color{R: blend(over.R, c.R), G: blend(over.G, c.G), B: blend(over.B, c.B), A: uint8(outAlpha*255 + 0.5)}
 }
 
 //LerpHSV will lerp the color towards another, using their calculated HSV to do so.
@@ -207,3 +172,103 @@ func (c Color) LerpHSV(target Color, amount float32) Color {
 	lerp := hsv1.Lerp(hsv2, amount)
 	return NewColorFromHSV(lerp)
 }
+
+//AdjustTemperature shifts the colour to be warmer or cooler based on a white balance in Kelvin.
+// 6500K is neutral daylight and leaves the colour unchanged, lower values are warmer (orange) and higher values are cooler (blue).
+// Uses a blackbody approximation and is clamped between 1000K and 40000K.
+func (c Color) AdjustTemperature(kelvin float32) Color {
+	balance := kelvinToRGB(kelvin)
+	neutral := kelvinToRGB(6500)
+	return Color{
+		R: uint8(Clamp32(float32(c.R)*balance.X/neutral.X, 0, 255) + 0.5),
+		G: uint8(Clamp32(float32(c.G)*balance.Y/neutral.Y, 0, 255) + 0.5),
+		B: uint8(Clamp32(float32(c.B)*balance.Z/neutral.Z, 0, 255) + 0.5),
+		A: c.A,
+	}
+}
+
+//DrawScreenTemperature tints everything drawn so far to be warmer or cooler based on a white balance in Kelvin.
+// This multiplies a full screen rectangle over the frame, so it should be called after the scene has been drawn.
+// See Color.AdjustTemperature.
+func DrawScreenTemperature(kelvin float32) {
+	BeginBlendMode(BlendMultiplied)
+	DrawRectangle(0, 0, GetScreenWidth(), GetScreenHeight(), White.AdjustTemperature(kelvin))
+	EndBlendMode()
+}
+
+//kelvinToRGB approximates the colour of a blackbody at the given temperature, with each component between [0..255].
+// Source: https://tannerhelland.com/2012/09/18/convert-temperature-rgb-algorithm-code.html
+func kelvinToRGB(kelvin float32) Vector3 {
+	temp := Clamp(float64(kelvin), 1000, 40000) / 100
+	var r, g, b float64
+
+	if temp <= 66 {
+		r = 255
+		g = 99.4708025861*math.Log(temp) - 161.1195681661
+	} else {
+		r = 329.698727446 * math.Pow(temp-60, -0.1332047592)
+		g = 288.1221695283 * math.Pow(temp-60, -0.0755148492)
+	}
+
+	if temp >= 66 {
+		b = 255
+	} else if temp <= 19 {
+		b = 0
+	} else {
+		b = 138.5177312231*math.Log(temp-10) - 305.0447927307
+	}
+
+	return NewVector3(float32(Clamp(r, 0, 255)), float32(Clamp(g, 0, 255)), float32(Clamp(b, 0, 255)))
+}
+
+//Equals checks if all the channels of both colours are the same
+func (c Color) Equals(other Color) bool { return c == other }
+
+//EqualsRGB checks if the red, green and blue channels of both colours are the same, ignoring the alpha
+func (c Color) EqualsRGB(other Color) bool {
+	return c.R == other.R && c.G == other.G && c.B == other.B
+}
+
+//RelativeLuminance gets the luminance of the colour as defined by WCAG 2, from 0 for black to 1 for white.
+// The alpha is ignored.
+func (c Color) RelativeLuminance() float64 {
+	linear := func(channel uint8) float64 {
+		v := float64(channel) / 255
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+//ContrastRatio calculates the WCAG contrast ratio between two colours, from 1 for identical colours to 21 for black on white.
+// The order of the colours does not matter.
+func ContrastRatio(fg, bg Color) float64 {
+	l1, l2 := fg.RelativeLuminance(), bg.RelativeLuminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+//MeetsWCAG checks if the colours have enough contrast for text at the WCAG level.
+// The level is one of "AA" or "AAA" for normal text, or "AA-large" or "AAA-large" for large text. Unknown levels never pass.
+func MeetsWCAG(fg, bg Color, level string) bool {
+	var required float64
+	switch level {
+	case "AA":
+		required = 4.5
+	case "AA-large":
+		required = 3
+	case "AAA":
+		required = 7
+	case "AAA-large":
+		required = 4.5
+	default:
+		return false
+	}
+
+	return ContrastRatio(fg, bg) >= required
+}
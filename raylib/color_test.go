@@ -0,0 +1,185 @@
+package raylib
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdjustTemperatureNeutral(t *testing.T) {
+	for _, color := range []Color{White, Black, Red, NewColor(12, 200, 99, 128)} {
+		if adjusted := color.AdjustTemperature(6500); adjusted != color {
+			t.Errorf("%v.AdjustTemperature(6500) = %v, want it unchanged", color, adjusted)
+		}
+	}
+}
+
+func TestAdjustTemperatureWarmAndCool(t *testing.T) {
+	gray := NewColor(128, 128, 128, 255)
+
+	//Warmer light drops the blue, while cooler light drops the red
+	warm := gray.AdjustTemperature(3000)
+	if warm.B >= gray.B || warm.R != gray.R || warm.A != gray.A {
+		t.Errorf("AdjustTemperature(3000) = %v, want less blue than %v", warm, gray)
+	}
+	cool := gray.AdjustTemperature(10000)
+	if cool.R >= gray.R || cool.B < gray.B || cool.A != gray.A {
+		t.Errorf("AdjustTemperature(10000) = %v, want less red than %v", cool, gray)
+	}
+
+	//Temperatures outside the range are clamped
+	if low, clamped := gray.AdjustTemperature(10), gray.AdjustTemperature(1000); low != clamped {
+		t.Errorf("AdjustTemperature(10) = %v, want it clamped to 1000K as %v", low, clamped)
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		fg, bg   Color
+		expected float64
+	}{
+		{"black on white", Black, White, 21},
+		{"white on black", White, Black, 21},
+		{"identical", Red, Red, 1},
+		{"#767676 on white", NewColorInt(0x767676FF), White, 4.54},
+		{"#777777 on white", NewColorInt(0x777777FF), White, 4.48},
+	}
+
+	for _, test := range tests {
+		if actual := ContrastRatio(test.fg, test.bg); math.Abs(actual-test.expected) > 0.01 {
+			t.Errorf("%s: ContrastRatio() = %v, want %v", test.name, actual, test.expected)
+		}
+	}
+
+	if luminance := White.RelativeLuminance(); math.Abs(luminance-1) > 1e-9 {
+		t.Errorf("White.RelativeLuminance() = %v, want 1", luminance)
+	}
+}
+
+func TestMeetsWCAG(t *testing.T) {
+	//#767676 is the lightest gray that passes AA on white
+	passing, failing := NewColorInt(0x767676FF), NewColorInt(0x777777FF)
+
+	tests := []struct {
+		fg       Color
+		level    string
+		expected bool
+	}{
+		{passing, "AA", true},
+		{failing, "AA", false},
+		{failing, "AA-large", true},
+		{passing, "AAA", false},
+		{Black, "AAA", true},
+		{failing, "AAA-large", false},
+		{Black, "unknown", false},
+	}
+
+	for _, test := range tests {
+		if actual := MeetsWCAG(test.fg, White, test.level); actual != test.expected {
+			t.Errorf("MeetsWCAG(%v, White, %q) = %v, want %v", test.fg, test.level, actual, test.expected)
+		}
+	}
+}
+
+func TestColorEquals(t *testing.T) {
+	tests := []struct {
+		a, b        Color
+		equals, rgb bool
+	}{
+		{Red, NewColor(230, 41, 55, 255), true, true},
+		{Red, NewColor(230, 41, 55, 0), false, true},
+		{Red, NewColor(230, 41, 56, 255), false, false},
+		{Blank, NewColor(0, 0, 0, 0), true, true},
+		{Blank, Black, false, true},
+	}
+
+	for _, test := range tests {
+		if actual := test.a.Equals(test.b); actual != test.equals {
+			t.Errorf("%v.Equals(%v) = %v, want %v", test.a, test.b, actual, test.equals)
+		}
+		if actual := test.a.EqualsRGB(test.b); actual != test.rgb {
+			t.Errorf("%v.EqualsRGB(%v) = %v, want %v", test.a, test.b, actual, test.rgb)
+		}
+	}
+}
+
+//colorsNearlyEqual checks if each channel of the colours are within one of each other, allowing for rounding
+func colorsNearlyEqual(a, b Color) bool {
+	near := func(x, y uint8) bool { return int(x)-int(y) <= 1 && int(y)-int(x) <= 1 }
+	return near(a.R, b.R) && near(a.G, b.G) && near(a.B, b.B) && near(a.A, b.A)
+}
+
+func TestHSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		color Color
+		hsv   Vector3
+	}{
+		{"red", NewColor(255, 0, 0, 255), NewVector3(0, 1, 1)},
+		{"green", NewColor(0, 255, 0, 255), NewVector3(120, 1, 1)},
+		{"blue", NewColor(0, 0, 255, 255), NewVector3(240, 1, 1)},
+		{"yellow", NewColor(255, 255, 0, 255), NewVector3(60, 1, 1)},
+		{"white", NewColor(255, 255, 255, 255), NewVector3(0, 0, 1)},
+		{"gray", NewColor(128, 128, 128, 255), NewVector3(0, 0, 128.0/255)},
+		{"black", NewColor(0, 0, 0, 255), NewVector3(0, 0, 0)},
+	}
+
+	for _, test := range tests {
+		hsv := test.color.ToHSV()
+		if !nearlyEqual(hsv.X, test.hsv.X) || !nearlyEqual(hsv.Y, test.hsv.Y) || !nearlyEqual(hsv.Z, test.hsv.Z) {
+			t.Errorf("%s: ToHSV() = %v, want %v", test.name, hsv, test.hsv)
+		}
+		if color := NewColorFromHSV(test.hsv); !colorsNearlyEqual(color, test.color) {
+			t.Errorf("%s: NewColorFromHSV(%v) = %v, want %v", test.name, test.hsv, color, test.color)
+		}
+	}
+
+	//The alpha survives a round trip through HSVA, but not HSV which is always opaque
+	faded := NewColor(0, 0, 255, 100)
+	if color := NewColorFromHSVA(faded.ToHSVA()); !colorsNearlyEqual(color, faded) {
+		t.Errorf("NewColorFromHSVA(ToHSVA()) = %v, want %v", color, faded)
+	}
+	if color := NewColorFromHSV(faded.ToHSV()); color.A != 255 {
+		t.Errorf("NewColorFromHSV(ToHSV()) alpha = %d, want 255", color.A)
+	}
+}
+
+func TestColorLerp(t *testing.T) {
+	from, to := NewColor(0, 0, 0, 0), NewColor(255, 100, 50, 255)
+	tests := []struct {
+		amount float32
+		want   Color
+	}{
+		{0, from},
+		{1, to},
+		{0.5, NewColor(128, 50, 25, 128)},
+		{-1, from},
+		{2, to},
+	}
+
+	for _, test := range tests {
+		if actual := from.Lerp(to, test.amount); actual != test.want {
+			t.Errorf("Lerp(%v, %v) = %v, want %v", to, test.amount, actual, test.want)
+		}
+	}
+}
+
+func TestColorAlphaBlend(t *testing.T) {
+	tests := []struct {
+		name      string
+		dst, over Color
+		want      Color
+	}{
+		{"transparent over", White, NewColor(0, 0, 0, 0), White},
+		{"opaque over", White, NewColor(0, 0, 0, 255), NewColor(0, 0, 0, 255)},
+		{"half over", White, NewColor(0, 0, 0, 128), NewColor(127, 127, 127, 255)},
+		{"over transparent", Blank, NewColor(255, 0, 0, 128), NewColor(255, 0, 0, 128)},
+		{"both transparent", Blank, Blank, Color{}},
+	}
+
+	for _, test := range tests {
+		if actual := test.dst.AlphaBlend(test.over); actual != test.want {
+			t.Errorf("%s: %v.AlphaBlend(%v) = %v, want %v", test.name, test.dst, test.over, actual, test.want)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package raylib
+
+/*
+#include "raylib.h"
+#include <stdlib.h>
+
+#define STBTT_STATIC
+#define STB_TRUETYPE_IMPLEMENTATION
+#include "external/stb_truetype.h"
+
+//Font data kept in memory, so glyphs can be rasterized without reading the file again
+typedef struct Go_FontSource {
+	unsigned char *data;
+	stbtt_fontinfo info;
+	float scale;
+	int ascent;
+} Go_FontSource;
+
+//Prepares the font data for rasterizing, taking ownership of the data. Returns NULL if it is not a valid font.
+static Go_FontSource *Go_LoadFontSource(unsigned char *data, int fontSize) {
+	Go_FontSource *source = (Go_FontSource *)calloc(1, sizeof(Go_FontSource));
+	if (!stbtt_InitFont(&source->info, data, 0)) {
+		free(data);
+		free(source);
+		return NULL;
+	}
+
+	int ascent, descent, lineGap;
+	stbtt_GetFontVMetrics(&source->info, &ascent, &descent, &lineGap);
+	source->data = data;
+	source->scale = stbtt_ScaleForPixelHeight(&source->info, (float)fontSize);
+	source->ascent = (int)((float)ascent*source->scale);
+	return source;
+}
+
+static void Go_UnloadFontSource(Go_FontSource *source) {
+	free(source->data);
+	free(source);
+}
+
+//Rasterizes a single codepoint the same way LoadFontData does for FONT_DEFAULT
+static unsigned char *Go_LoadGlyph(Go_FontSource *source, int codepoint, int *width, int *height, int *offsetX, int *offsetY, int *advanceX) {
+	unsigned char *data = stbtt_GetCodepointBitmap(&source->info, source->scale, source->scale, codepoint, width, height, offsetX, offsetY);
+	*offsetY += source->ascent;
+
+	int advance;
+	stbtt_GetCodepointHMetrics(&source->info, codepoint, &advance, NULL);
+	*advanceX = (int)((float)advance*source->scale);
+	return data;
+}
+*/
+import "C"
+import (
+	"fmt"
+	"io/ioutil"
+	"unsafe"
+)
+
+//DynamicFont loads glyphs from a font file as they are needed and caches them in a texture atlas.
+// This avoids loading every glyph of fonts with huge character sets, such as CJK fonts, up front.
+// The atlas starts small and doubles in size when it is full. Once it reaches MaxAtlasSize, the cache is cleared and rebuilt.
+type DynamicFont struct {
+	//FileName is the font file glyphs are loaded from
+	FileName string
+	//FontSize is the size glyphs are rasterized at
+	FontSize int
+	//MaxAtlasSize is the largest width and height the atlas can grow to
+	MaxAtlasSize int
+
+	glyphs    map[rune]*dynamicGlyph
+	atlas     []Color
+	atlasSize int
+	packer    *shelfPacker
+	texture   Texture2D
+	dirty     bool
+
+	source    *C.Go_FontSource
+	rasterize func(codepoint rune) (glyphBitmap, bool)
+}
+
+//dynamicGlyph is a glyph that has been packed into the atlas
+type dynamicGlyph struct {
+	rec      Rectangle
+	offset   Vector2
+	advanceX float32
+}
+
+//glyphBitmap is a rasterized glyph that has not been packed yet
+type glyphBitmap struct {
+	width, height int
+	alpha         []byte
+	offsetX       int
+	offsetY       int
+	advanceX      int
+}
+
+const dynamicFontPadding = 1
+
+//LoadDynamicFont prepares a font that loads its glyphs on demand. The font file is read once and kept in memory,
+// but no glyphs are rasterized until they are used.
+func LoadDynamicFont(fileName string, fontSize int) *DynamicFont {
+	font := newDynamicFont(fileName, fontSize, 256, 4096)
+	font.rasterize = font.rasterizeFromSource
+	if err := font.loadSource(); err != nil {
+		TraceLog(LogWarning, "[FONT] Failed to load dynamic font: ", err)
+	}
+	font.texture = font.atlasTexture()
+
+	RegisterUnloadable(font)
+	return font
+}
+
+//newDynamicFont creates the font without any texture, so the caching can be used without a window
+func newDynamicFont(fileName string, fontSize, initialSize, maxSize int) *DynamicFont {
+	return &DynamicFont{
+		FileName:     fileName,
+		FontSize:     fontSize,
+		MaxAtlasSize: maxSize,
+		glyphs:       make(map[rune]*dynamicGlyph),
+		atlas:        make([]Color, initialSize*initialSize),
+		atlasSize:    initialSize,
+		packer:       newShelfPacker(initialSize, initialSize),
+	}
+}
+
+//Unload unloads the atlas texture and the font data
+func (font *DynamicFont) Unload() {
+	font.texture.Unload()
+	font.unloadSource()
+	UnregisterUnloadable(font)
+}
+
+//loadSource reads the font file into memory, ready for rasterizeFromSource
+func (font *DynamicFont) loadSource() error {
+	data, err := ioutil.ReadFile(font.FileName)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("%s is empty", font.FileName)
+	}
+
+	//The C side owns the copy, as the font info keeps pointing into it
+	font.source = C.Go_LoadFontSource((*C.uchar)(C.CBytes(data)), C.int(font.FontSize))
+	if font.source == nil {
+		return fmt.Errorf("%s is not a valid TTF font", font.FileName)
+	}
+	return nil
+}
+
+//unloadSource frees the font data
+func (font *DynamicFont) unloadSource() {
+	if font.source != nil {
+		C.Go_UnloadFontSource(font.source)
+		font.source = nil
+	}
+}
+
+//rasterizeFromSource rasterizes a single glyph from the font data in memory
+func (font *DynamicFont) rasterizeFromSource(codepoint rune) (glyphBitmap, bool) {
+	if font.source == nil {
+		return glyphBitmap{}, false
+	}
+
+	var width, height, offsetX, offsetY, advanceX C.int
+	data := C.Go_LoadGlyph(font.source, C.int(codepoint), &width, &height, &offsetX, &offsetY, &advanceX)
+	defer C.free(unsafe.Pointer(data))
+
+	//The bitmap is a grayscale image of the coverage
+	bitmap := glyphBitmap{
+		width:    int(width),
+		height:   int(height),
+		offsetX:  int(offsetX),
+		offsetY:  int(offsetY),
+		advanceX: int(advanceX),
+	}
+	if bitmap.width > 0 && bitmap.height > 0 && data != nil {
+		bitmap.alpha = C.GoBytes(unsafe.Pointer(data), C.int(bitmap.width*bitmap.height))
+	}
+
+	return bitmap, true
+}
+
+//glyph gets a glyph from the cache, loading it into the atlas if it has not been used before.
+// Returns false if the glyph could not be loaded.
+func (font *DynamicFont) glyph(codepoint rune) (*dynamicGlyph, bool) {
+	if glyph, ok := font.glyphs[codepoint]; ok {
+		return glyph, true
+	}
+
+	bitmap, ok := font.rasterize(codepoint)
+	if !ok {
+		return nil, false
+	}
+
+	x, y, ok := font.pack(bitmap.width+dynamicFontPadding*2, bitmap.height+dynamicFontPadding*2)
+	if !ok {
+		TraceLog(LogWarning, "[FONT] Glyph ", codepoint, " is too large for the dynamic font atlas")
+		return nil, false
+	}
+
+	//Copy the coverage into the atlas as white with alpha, like raylib's own fonts
+	x, y = x+dynamicFontPadding, y+dynamicFontPadding
+	for gy := 0; gy < bitmap.height; gy++ {
+		for gx := 0; gx < bitmap.width; gx++ {
+			var alpha uint8
+			if bitmap.alpha != nil {
+				alpha = bitmap.alpha[gx+gy*bitmap.width]
+			}
+			font.atlas[(x+gx)+(y+gy)*font.atlasSize] = NewColor(255, 255, 255, alpha)
+		}
+	}
+
+	glyph := &dynamicGlyph{
+		rec:      NewRectangle(float32(x), float32(y), float32(bitmap.width), float32(bitmap.height)),
+		offset:   NewVector2(float32(bitmap.offsetX), float32(bitmap.offsetY)),
+		advanceX: float32(bitmap.advanceX),
+	}
+	font.glyphs[codepoint] = glyph
+	font.dirty = true
+	return glyph, true
+}
+
+//pack finds space in the atlas, growing it or clearing the cache when it is full
+func (font *DynamicFont) pack(width, height int) (int, int, bool) {
+	for {
+		if x, y, ok := font.packer.insert(width, height); ok {
+			return x, y, true
+		}
+
+		if font.atlasSize*2 <= font.MaxAtlasSize {
+			font.grow(font.atlasSize * 2)
+			continue
+		}
+
+		//Cannot grow any further. If the atlas is already empty the glyph will never fit.
+		if len(font.glyphs) == 0 {
+			return 0, 0, false
+		}
+		font.Clear()
+	}
+}
+
+//grow resizes the atlas, keeping the existing glyphs where they are
+func (font *DynamicFont) grow(size int) {
+	atlas := make([]Color, size*size)
+	for y := 0; y < font.atlasSize; y++ {
+		copy(atlas[y*size:], font.atlas[y*font.atlasSize:(y+1)*font.atlasSize])
+	}
+
+	font.atlas = atlas
+	font.atlasSize = size
+	font.packer.grow(size, size)
+	font.dirty = true
+
+	//The texture has to be recreated at the new size
+	if font.texture.Id != 0 {
+		font.texture.Unload()
+		font.texture = font.atlasTexture()
+	}
+}
+
+//Clear removes every glyph from the cache, leaving the atlas at its current size
+func (font *DynamicFont) Clear() {
+	font.glyphs = make(map[rune]*dynamicGlyph)
+	font.atlas = make([]Color, font.atlasSize*font.atlasSize)
+	font.packer = newShelfPacker(font.atlasSize, font.atlasSize)
+	font.dirty = true
+}
+
+//atlasTexture creates a texture of the atlas. The font unloads the texture itself, so it is not left registered for UnloadAll.
+func (font *DynamicFont) atlasTexture() Texture2D {
+	image := LoadImageEx(font.atlas, int32(font.atlasSize), int32(font.atlasSize))
+	defer image.Unload()
+
+	texture := LoadTextureFromImage(image)
+	UnregisterUnloadable(texture)
+	return texture
+}
+
+//upload sends the atlas to the texture if glyphs have been added since the last upload
+func (font *DynamicFont) upload() {
+	if font.dirty && font.texture.Id != 0 {
+		font.texture.UpdateTexture(font.atlas)
+		font.dirty = false
+	}
+}
+
+//Preload loads all the glyphs in the text into the atlas, so they do not need to be loaded while drawing
+func (font *DynamicFont) Preload(text string) {
+	for _, codepoint := range text {
+		font.glyph(codepoint)
+	}
+}
+
+//CachedCount is the number of glyphs currently in the atlas
+func (font *DynamicFont) CachedCount() int { return len(font.glyphs) }
+
+//Texture is the atlas texture, useful for debugging
+func (font *DynamicFont) Texture() Texture2D {
+	font.upload()
+	return font.texture
+}
+
+//MeasureText measures the size of the text, loading any glyphs it needs
+func (font *DynamicFont) MeasureText(text string, spacing float32) Vector2 {
+	lineHeight := float32(font.FontSize + font.FontSize/2)
+	size := NewVector2(0, float32(font.FontSize))
+	width := float32(0)
+
+	for _, codepoint := range text {
+		if codepoint == '\n' {
+			size.Y += lineHeight
+			width = 0
+			continue
+		}
+
+		if glyph, ok := font.glyph(codepoint); ok {
+			width += glyph.advanceX + spacing
+		}
+		if width > size.X {
+			size.X = width
+		}
+	}
+
+	return size
+}
+
+//DrawText draws the text at the position, loading any glyphs it needs
+func (font *DynamicFont) DrawText(text string, position Vector2, spacing float32, tint Color) {
+	//Load everything first so the atlas is only uploaded once
+	font.Preload(text)
+	font.upload()
+
+	lineHeight := float32(font.FontSize + font.FontSize/2)
+	pen := position
+	for _, codepoint := range text {
+		if codepoint == '\n' {
+			pen.X = position.X
+			pen.Y += lineHeight
+			continue
+		}
+
+		glyph, ok := font.glyph(codepoint)
+		if !ok {
+			continue
+		}
+
+		if glyph.rec.Width > 0 && glyph.rec.Height > 0 {
+			DrawTextureRec(font.texture, glyph.rec, pen.Add(glyph.offset), tint)
+		}
+		pen.X += glyph.advanceX + spacing
+	}
+}
+
+//shelfPacker packs rectangles into rows, starting a new row when the current one is full
+type shelfPacker struct {
+	width, height int
+	x, y          int
+	rowHeight     int
+}
+
+func newShelfPacker(width, height int) *shelfPacker {
+	return &shelfPacker{width: width, height: height}
+}
+
+//insert finds space for the rectangle, returning false if there is no room left
+func (packer *shelfPacker) insert(width, height int) (int, int, bool) {
+	if width > packer.width || height > packer.height {
+		return 0, 0, false
+	}
+
+	//Start a new row if this one is full
+	if packer.x+width > packer.width {
+		packer.y += packer.rowHeight
+		packer.x = 0
+		packer.rowHeight = 0
+	}
+
+	if packer.y+height > packer.height {
+		return 0, 0, false
+	}
+
+	x, y := packer.x, packer.y
+	packer.x += width
+	if height > packer.rowHeight {
+		packer.rowHeight = height
+	}
+	return x, y, true
+}
+
+//grow makes more room available. Existing rows keep their width, so new space is used from the next row.
+func (packer *shelfPacker) grow(width, height int) {
+	packer.width = width
+	packer.height = height
+}
@@ -0,0 +1,116 @@
+package raylib
+
+import "testing"
+
+//countingRasterizer makes square glyphs of the size, counting how often each codepoint is rasterized
+func countingRasterizer(size int, counts map[rune]int) func(codepoint rune) (glyphBitmap, bool) {
+	return func(codepoint rune) (glyphBitmap, bool) {
+		counts[codepoint]++
+		alpha := make([]byte, size*size)
+		for i := range alpha {
+			alpha[i] = 200
+		}
+		return glyphBitmap{width: size, height: size, alpha: alpha, advanceX: size}, true
+	}
+}
+
+func TestDynamicFontCaching(t *testing.T) {
+	counts := make(map[rune]int)
+	font := newDynamicFont("test.ttf", 16, 64, 128)
+	font.rasterize = countingRasterizer(8, counts)
+
+	//Each new codepoint is rasterized and inserted once
+	font.Preload("你好你")
+	if font.CachedCount() != 2 {
+		t.Errorf("CachedCount() = %d, want 2", font.CachedCount())
+	}
+	if counts['你'] != 1 || counts['好'] != 1 {
+		t.Errorf("rasterized %v, want each glyph once", counts)
+	}
+
+	glyph, ok := font.glyph('你')
+	if !ok {
+		t.Fatal("glyph('你') was not found")
+	}
+	if glyph.rec.Width != 8 || glyph.rec.Height != 8 || glyph.advanceX != 8 {
+		t.Errorf("glyph = %+v, want an 8x8 rectangle advancing by 8", glyph)
+	}
+
+	//The coverage is copied into the atlas as white with alpha, inside the padding
+	x, y := int(glyph.rec.X), int(glyph.rec.Y)
+	if x != dynamicFontPadding || y != dynamicFontPadding {
+		t.Errorf("first glyph is at %d, %d, want inside the padding at %d, %d", x, y, dynamicFontPadding, dynamicFontPadding)
+	}
+	if pixel := font.atlas[x+y*font.atlasSize]; pixel != NewColor(255, 255, 255, 200) {
+		t.Errorf("atlas pixel = %v, want white with the glyph coverage", pixel)
+	}
+
+	//Measuring and loading again are cache hits
+	if size := font.MeasureText("你好", 0); size.X != 16 {
+		t.Errorf("MeasureText() width = %v, want 16", size.X)
+	}
+	if counts['你'] != 1 || counts['好'] != 1 {
+		t.Errorf("rasterized %v after cache hits, want each glyph still once", counts)
+	}
+}
+
+func TestDynamicFontGrowsAndClears(t *testing.T) {
+	counts := make(map[rune]int)
+	font := newDynamicFont("test.ttf", 16, 32, 64)
+	font.rasterize = countingRasterizer(14, counts)
+
+	//Padded glyphs are 16x16, so 4 fill the first atlas. Growing keeps the two full rows at their old width,
+	// leaving room for 8 more below them.
+	for codepoint := rune('A'); codepoint < 'A'+12; codepoint++ {
+		if _, ok := font.glyph(codepoint); !ok {
+			t.Fatalf("glyph(%q) was not loaded", codepoint)
+		}
+	}
+	if font.atlasSize != 64 || font.CachedCount() != 12 {
+		t.Errorf("atlas is %d with %d glyphs, want 64 with 12", font.atlasSize, font.CachedCount())
+	}
+
+	//The next glyph does not fit, so the cache is cleared and the glyph has to be rasterized again later
+	font.glyph('Z')
+	if font.CachedCount() != 1 {
+		t.Errorf("CachedCount() after clearing = %d, want 1", font.CachedCount())
+	}
+	font.glyph('A')
+	if counts['A'] != 2 {
+		t.Errorf("'A' was rasterized %d times, want 2 after it was evicted", counts['A'])
+	}
+}
+
+func TestDynamicFontRasterizeFromSource(t *testing.T) {
+	font := newDynamicFont("../raylib-example/resources/fonts/AnonymousPro-Bold.ttf", 20, 64, 256)
+	if err := font.loadSource(); err != nil {
+		t.Fatal(err)
+	}
+	defer font.unloadSource()
+
+	bitmap, ok := font.rasterizeFromSource('A')
+	if !ok {
+		t.Fatal("rasterizeFromSource('A') failed")
+	}
+	if bitmap.width <= 0 || bitmap.height <= 0 || len(bitmap.alpha) != bitmap.width*bitmap.height {
+		t.Errorf("bitmap is %dx%d with %d bytes, want a non-empty coverage image", bitmap.width, bitmap.height, len(bitmap.alpha))
+	}
+	if bitmap.advanceX <= 0 || bitmap.advanceX > 20 {
+		t.Errorf("advanceX = %d, want a positive advance no wider than the font size", bitmap.advanceX)
+	}
+
+	//The same data is used for every glyph, without reading the file again
+	if space, ok := font.rasterizeFromSource(' '); !ok || space.alpha != nil || space.advanceX != bitmap.advanceX {
+		t.Errorf("space = %+v, want an empty bitmap with the same monospaced advance", space)
+	}
+}
+
+func TestDynamicFontLoadSourceMissing(t *testing.T) {
+	font := newDynamicFont("missing.ttf", 20, 64, 256)
+	if err := font.loadSource(); err == nil {
+		t.Error("loadSource() of a missing file succeeded")
+	}
+	if _, ok := font.rasterizeFromSource('A'); ok {
+		t.Error("rasterizeFromSource() without a source succeeded")
+	}
+}
@@ -0,0 +1,64 @@
+package raylib
+
+//EventBus lets game systems communicate by publishing events on topics, without knowing about each other.
+// Handlers are invoked synchronously in the order they subscribed.
+type EventBus struct {
+	handlers map[string][]eventHandler
+	nextID   int
+}
+
+type eventHandler struct {
+	id int
+	fn func(data interface{})
+}
+
+//NewEventBus creates a new event bus without any subscribers
+func NewEventBus() *EventBus {
+	return &EventBus{handlers: make(map[string][]eventHandler)}
+}
+
+//Subscribe registers a handler for the topic. The returned id is used to unsubscribe it.
+func (bus *EventBus) Subscribe(topic string, fn func(data interface{})) int {
+	bus.nextID++
+	bus.handlers[topic] = append(bus.handlers[topic], eventHandler{id: bus.nextID, fn: fn})
+	return bus.nextID
+}
+
+//Unsubscribe removes the handler with the id from the topic. Returns false if it was not subscribed.
+func (bus *EventBus) Unsubscribe(topic string, id int) bool {
+	handlers := bus.handlers[topic]
+	for i, handler := range handlers {
+		if handler.id == id {
+			//Copy into a new slice so a publish that is in progress is not affected
+			remaining := make([]eventHandler, 0, len(handlers)-1)
+			remaining = append(remaining, handlers[:i]...)
+			remaining = append(remaining, handlers[i+1:]...)
+
+			if len(remaining) == 0 {
+				delete(bus.handlers, topic)
+			} else {
+				bus.handlers[topic] = remaining
+			}
+			return true
+		}
+	}
+	return false
+}
+
+//Publish invokes every handler subscribed to the topic with the data.
+// Handlers that subscribe or unsubscribe while the event is being published take effect from the next publish.
+func (bus *EventBus) Publish(topic string, data interface{}) {
+	for _, handler := range bus.handlers[topic] {
+		handler.fn(data)
+	}
+}
+
+//HasSubscribers checks if the topic has any handlers
+func (bus *EventBus) HasSubscribers(topic string) bool {
+	return len(bus.handlers[topic]) > 0
+}
+
+//Clear removes every handler from every topic
+func (bus *EventBus) Clear() {
+	bus.handlers = make(map[string][]eventHandler)
+}
@@ -0,0 +1,71 @@
+package raylib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEventBusSubscribers(t *testing.T) {
+	bus := NewEventBus()
+	calls := make([]string, 0)
+
+	first := bus.Subscribe("hit", func(data interface{}) { calls = append(calls, "first:"+data.(string)) })
+	bus.Subscribe("hit", func(data interface{}) { calls = append(calls, "second:"+data.(string)) })
+	bus.Subscribe("other", func(data interface{}) { calls = append(calls, "other") })
+
+	bus.Publish("hit", "a")
+	if expected := []string{"first:a", "second:a"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Publish() called %v, want %v", calls, expected)
+	}
+
+	calls = calls[:0]
+	if !bus.Unsubscribe("hit", first) {
+		t.Error("Unsubscribe() of a subscribed handler = false, want true")
+	}
+	if bus.Unsubscribe("hit", first) {
+		t.Error("Unsubscribe() of an already removed handler = true, want false")
+	}
+
+	bus.Publish("hit", "b")
+	if expected := []string{"second:b"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Publish() after Unsubscribe() called %v, want %v", calls, expected)
+	}
+	if !bus.HasSubscribers("other") || bus.HasSubscribers("missing") {
+		t.Error("HasSubscribers() did not match the subscribed topics")
+	}
+}
+
+func TestEventBusUnsubscribeDuringPublish(t *testing.T) {
+	bus := NewEventBus()
+	calls := 0
+
+	var second int
+	bus.Subscribe("tick", func(data interface{}) {
+		calls++
+		bus.Unsubscribe("tick", second)
+	})
+	second = bus.Subscribe("tick", func(data interface{}) { calls++ })
+
+	//The second handler is still called for the event in progress, but not the next one
+	bus.Publish("tick", nil)
+	if calls != 2 {
+		t.Errorf("handlers called during the first Publish() = %d, want 2", calls)
+	}
+
+	calls = 0
+	bus.Publish("tick", nil)
+	if calls != 1 {
+		t.Errorf("handlers called during the second Publish() = %d, want 1", calls)
+	}
+}
+
+func TestEventBusClear(t *testing.T) {
+	bus := NewEventBus()
+	id := bus.Subscribe("hit", func(data interface{}) { t.Error("handler called after Clear()") })
+	bus.Clear()
+	bus.Publish("hit", nil)
+
+	if bus.HasSubscribers("hit") || bus.Unsubscribe("hit", id) {
+		t.Error("topic still has subscribers after Clear()")
+	}
+}
@@ -0,0 +1,70 @@
+package raylib
+
+import (
+	"os"
+	"path/filepath"
+)
+
+/*
+The file management functions from raylib are not converted as Go already handles them well.
+These are the few that are useful for locating assets.
+*/
+
+//GetApplicationDirectory gets the directory that the running executable is in.
+// Symlinks to the executable are resolved, so this is the directory of the actual binary.
+func GetApplicationDirectory() (string, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+
+	return filepath.Dir(executable), nil
+}
+
+//GetWorkingDirectory gets the current working directory
+func GetWorkingDirectory() (string, error) {
+	return os.Getwd()
+}
+
+//ChangeDirectory changes the current working directory
+func ChangeDirectory(dir string) error {
+	return os.Chdir(dir)
+}
+
+//ResolveAssetPath joins a path relative to the application directory, so assets can be found no matter where the
+// executable is launched from. Absolute paths are returned cleaned but otherwise unchanged. If the application directory
+// cannot be found, the path is left relative to the working directory.
+func ResolveAssetPath(rel string) string {
+	if filepath.IsAbs(rel) {
+		return filepath.Clean(rel)
+	}
+
+	dir, err := GetApplicationDirectory()
+	if err != nil {
+		TraceLog(LogWarning, "[FILES] Failed to find the application directory: ", err)
+		return filepath.Clean(rel)
+	}
+
+	return filepath.Join(dir, rel)
+}
+
+//DroppedFiles gets the paths of the files dropped onto the window since the last call, or an empty slice if nothing was dropped.
+// The paths are copied into Go strings and raylib's buffer is cleared, so each drop is only returned once.
+func DroppedFiles() []string {
+	return droppedFiles(IsFileDropped, GetDroppedFiles, ClearDroppedFiles)
+}
+
+//droppedFiles takes the dropped files with the given functions, clearing them once they have been read
+func droppedFiles(isDropped func() bool, getDropped func() []string, clearDropped func()) []string {
+	if !isDropped() {
+		return []string{}
+	}
+
+	files := getDropped()
+	clearDropped()
+	return files
+}
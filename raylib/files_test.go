@@ -0,0 +1,98 @@
+package raylib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetApplicationDirectory(t *testing.T) {
+	dir, err := GetApplicationDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//The test binary is the running executable
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+	if dir != filepath.Dir(executable) {
+		t.Errorf("GetApplicationDirectory() = %q, want %q", dir, filepath.Dir(executable))
+	}
+}
+
+func TestChangeDirectory(t *testing.T) {
+	original, err := GetWorkingDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(original)
+
+	dir := t.TempDir()
+	if err := ChangeDirectory(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	working, err := GetWorkingDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolved, _ := filepath.EvalSymlinks(dir)
+	if working != dir && working != resolved {
+		t.Errorf("GetWorkingDirectory() after ChangeDirectory(%q) = %q", dir, working)
+	}
+
+	if err := ChangeDirectory(filepath.Join(dir, "missing")); err == nil {
+		t.Error("ChangeDirectory() to a missing directory succeeded")
+	}
+}
+
+func TestResolveAssetPath(t *testing.T) {
+	dir, err := GetApplicationDirectory()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	absolute := filepath.Join(t.TempDir(), "sprites", "player.png")
+	tests := []struct {
+		rel, expected string
+	}{
+		{"player.png", filepath.Join(dir, "player.png")},
+		{filepath.FromSlash("assets/sprites/"), filepath.Join(dir, "assets", "sprites")},
+		{filepath.FromSlash("assets//sprites/../player.png"), filepath.Join(dir, "assets", "player.png")},
+		{"", dir},
+		{absolute + string(filepath.Separator), absolute},
+	}
+
+	for _, test := range tests {
+		if actual := ResolveAssetPath(test.rel); actual != test.expected {
+			t.Errorf("ResolveAssetPath(%q) = %q, want %q", test.rel, actual, test.expected)
+		}
+	}
+}
+
+func TestDroppedFiles(t *testing.T) {
+	dropped := []string{}
+	isDropped := func() bool { return len(dropped) > 0 }
+	getDropped := func() []string { return append([]string(nil), dropped...) }
+	clearDropped := func() { dropped = nil }
+
+	if files := droppedFiles(isDropped, getDropped, clearDropped); files == nil || len(files) != 0 {
+		t.Errorf("droppedFiles() with nothing dropped = %#v, want an empty slice", files)
+	}
+
+	dropped = []string{"/tmp/level.json", "/tmp/sprite.png"}
+	files := droppedFiles(isDropped, getDropped, clearDropped)
+	if len(files) != 2 || files[0] != "/tmp/level.json" || files[1] != "/tmp/sprite.png" {
+		t.Errorf("droppedFiles() = %v, want both dropped files", files)
+	}
+
+	//Each drop is only returned once
+	if files := droppedFiles(isDropped, getDropped, clearDropped); len(files) != 0 {
+		t.Errorf("droppedFiles() a second time = %v, want nothing", files)
+	}
+}
@@ -0,0 +1,100 @@
+package raylib
+
+import "math"
+
+//DrawCameraGizmo draws the view of a 3D camera for debugging, along with its axes.
+// The view is drawn as a pyramid (or box for orthographic cameras) ending at the camera's target, using the screen's aspect ratio.
+// This should be drawn in 3D mode through a different camera.
+func DrawCameraGizmo(camera Camera) {
+	aspect := float32(1)
+	if GetScreenHeight() > 0 {
+		aspect = float32(GetScreenWidth()) / float32(GetScreenHeight())
+	}
+
+	distance := camera.Target.Distance(camera.Position)
+	far := cameraViewCorners(camera, aspect, distance)
+
+	//The edges from the camera to the view
+	if camera.Type == CameraTypeOrthographic {
+		near := cameraViewCorners(camera, aspect, 0)
+		for i := 0; i < 4; i++ {
+			DrawLine3D(near[i], near[(i+1)%4], Gray)
+			DrawLine3D(near[i], far[i], Gray)
+		}
+	} else {
+		for i := 0; i < 4; i++ {
+			DrawLine3D(camera.Position, far[i], Gray)
+		}
+	}
+
+	for i := 0; i < 4; i++ {
+		DrawLine3D(far[i], far[(i+1)%4], Yellow)
+	}
+
+	//The axis of the camera
+	forward, right, up := cameraBasis(camera)
+	length := distance * 0.25
+	DrawLine3D(camera.Position, camera.Position.Add(right.Scale(length)), Red)
+	DrawLine3D(camera.Position, camera.Position.Add(up.Scale(length)), Green)
+	DrawLine3D(camera.Position, camera.Position.Add(forward.Scale(length)), Blue)
+}
+
+//DrawCamera2DGizmo draws the area of the world a 2D camera can see, with a cross on its target.
+// This should be drawn in 2D mode through a different camera.
+func DrawCamera2DGizmo(camera Camera2D) {
+	corners := camera2DViewCorners(camera, float32(GetScreenWidth()), float32(GetScreenHeight()))
+	for i := 0; i < 4; i++ {
+		DrawLineV(corners[i], corners[(i+1)%4], Yellow)
+	}
+
+	size := float32(8)
+	if camera.Zoom != 0 {
+		size /= camera.Zoom
+	}
+	DrawLineV(camera.Target.Add(NewVector2(-size, 0)), camera.Target.Add(NewVector2(size, 0)), Red)
+	DrawLineV(camera.Target.Add(NewVector2(0, -size)), camera.Target.Add(NewVector2(0, size)), Green)
+}
+
+//cameraBasis gets the normalized forward, right and up directions of the camera
+func cameraBasis(camera Camera) (forward, right, up Vector3) {
+	forward = camera.Target.Subtract(camera.Position).Normalize()
+	right = forward.CrossProduct(camera.Up).Normalize()
+	up = right.CrossProduct(forward)
+	return
+}
+
+//cameraViewCorners gets the corners of the camera's view at a distance in front of it.
+// The corners go around from the top left, through the top right, bottom right then bottom left.
+func cameraViewCorners(camera Camera, aspect, distance float32) [4]Vector3 {
+	forward, right, up := cameraBasis(camera)
+
+	var halfHeight float32
+	if camera.Type == CameraTypeOrthographic {
+		halfHeight = camera.FOVY / 2
+	} else {
+		halfHeight = float32(math.Tan(float64(camera.FOVY*Deg2Rad)/2)) * distance
+	}
+	halfWidth := halfHeight * aspect
+
+	center := camera.Position.Add(forward.Scale(distance))
+	return [4]Vector3{
+		center.Add(up.Scale(halfHeight)).Subtract(right.Scale(halfWidth)),
+		center.Add(up.Scale(halfHeight)).Add(right.Scale(halfWidth)),
+		center.Subtract(up.Scale(halfHeight)).Add(right.Scale(halfWidth)),
+		center.Subtract(up.Scale(halfHeight)).Subtract(right.Scale(halfWidth)),
+	}
+}
+
+//camera2DViewCorners gets the corners of the screen in world space, from the top left going clockwise
+func camera2DViewCorners(camera Camera2D, screenWidth, screenHeight float32) [4]Vector2 {
+	if camera.Zoom == 0 {
+		camera.Zoom = 1
+	}
+
+	return [4]Vector2{
+		camera.screenToWorld(NewVector2(0, 0)),
+		camera.screenToWorld(NewVector2(screenWidth, 0)),
+		camera.screenToWorld(NewVector2(screenWidth, screenHeight)),
+		camera.screenToWorld(NewVector2(0, screenHeight)),
+	}
+}
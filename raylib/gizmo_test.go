@@ -0,0 +1,49 @@
+package raylib
+
+import "testing"
+
+func TestCamera2DViewCorners(t *testing.T) {
+	tests := []struct {
+		name     string
+		camera   Camera2D
+		expected [4]Vector2
+	}{
+		{
+			"identity",
+			Camera2D{Zoom: 1},
+			[4]Vector2{NewVector2(0, 0), NewVector2(800, 0), NewVector2(800, 600), NewVector2(0, 600)},
+		},
+		{
+			"zero zoom",
+			Camera2D{},
+			[4]Vector2{NewVector2(0, 0), NewVector2(800, 0), NewVector2(800, 600), NewVector2(0, 600)},
+		},
+		{
+			"centered and zoomed",
+			Camera2D{Offset: NewVector2(400, 300), Target: NewVector2(100, 100), Zoom: 2},
+			[4]Vector2{NewVector2(-100, -50), NewVector2(300, -50), NewVector2(300, 250), NewVector2(-100, 250)},
+		},
+	}
+
+	for _, test := range tests {
+		corners := camera2DViewCorners(test.camera, 800, 600)
+		for i := range corners {
+			if !vector2NearlyEqual(corners[i], test.expected[i]) {
+				t.Errorf("%s: corner %d = %v, want %v", test.name, i, corners[i], test.expected[i])
+			}
+		}
+	}
+}
+
+func TestCamera2DViewCornersRotated(t *testing.T) {
+	//Rotated cameras should agree with raylib's own screen to world conversion
+	camera := Camera2D{Offset: NewVector2(400, 300), Target: NewVector2(50, -20), Rotation: 30, Zoom: 1.5}
+	screen := [4]Vector2{NewVector2(0, 0), NewVector2(800, 0), NewVector2(800, 600), NewVector2(0, 600)}
+
+	corners := camera2DViewCorners(camera, 800, 600)
+	for i := range corners {
+		if expected := GetScreenToWorld2D(screen[i], camera); !vector2NearlyEqual(corners[i], expected) {
+			t.Errorf("corner %d = %v, want %v", i, corners[i], expected)
+		}
+	}
+}
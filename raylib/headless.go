@@ -0,0 +1,34 @@
+package raylib
+
+/*
+Headless rendering creates the window hidden so drawing can happen without anything showing on screen.
+This still requires a working OpenGL driver; on a CI machine without a display, run under a virtual
+framebuffer such as Xvfb with a software renderer like Mesa's llvmpipe.
+*/
+
+//InitHeadless initializes a hidden window and OpenGL context of the given size.
+// Drawing works as normal and the results can be read back with ReadFramebuffer. Close it with CloseWindow.
+// This is not an offscreen context, so it still needs a display server. Use IsWindowReady to check it was created.
+func InitHeadless(width, height int) {
+	SetConfigFlags(FlagWindowHidden)
+	InitWindow(width, height, "headless")
+}
+
+//ReadFramebuffer reads the pixels that have been drawn to the screen buffer into a new image.
+// This should be called after EndDrawing so the frame has been completed.
+func ReadFramebuffer() *Image {
+	return GetScreenData()
+}
+
+//ReadFramebufferPixel reads the colour of a single pixel on the screen buffer.
+// Reading many pixels this way is slow as the whole buffer is read each time, use ReadFramebuffer instead.
+func ReadFramebufferPixel(x, y int) Color {
+	image := ReadFramebuffer()
+	defer image.Unload()
+
+	if x < 0 || y < 0 || x >= int(image.Width) || y >= int(image.Height) {
+		return Color{}
+	}
+
+	return image.GetPixels()[x+y*int(image.Width)]
+}
@@ -0,0 +1,38 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import "testing"
+
+func TestHeadlessDrawRectangle(t *testing.T) {
+	requireWindow(t)
+
+	var inside, outside Color
+	onMainThread(func() {
+		BeginDrawing()
+		ClearBackground(Black)
+		DrawRectangle(8, 8, 16, 16, Red)
+		EndDrawing()
+
+		inside = ReadFramebufferPixel(16, 16)
+		outside = ReadFramebufferPixel(40, 40)
+	})
+
+	if inside != Red {
+		t.Errorf("pixel inside the rectangle = %v, want %v", inside, Red)
+	}
+	if outside != Black {
+		t.Errorf("pixel outside the rectangle = %v, want %v", outside, Black)
+	}
+}
+
+func TestReadFramebufferPixelOutOfBounds(t *testing.T) {
+	requireWindow(t)
+
+	var pixel Color
+	onMainThread(func() { pixel = ReadFramebufferPixel(-1, integrationHeight) })
+	if pixel != (Color{}) {
+		t.Errorf("ReadFramebufferPixel() outside the screen = %v, want an empty colour", pixel)
+	}
+}
@@ -0,0 +1,62 @@
+package raylib
+
+import "sort"
+
+//IDAllocator hands out unique integer ids, such as for entities, and reuses ids once they are released.
+// Ids start at 1 so that 0 can be used to mean no id. Released ids are reused lowest first, so the order of ids is deterministic.
+type IDAllocator struct {
+	next int
+	free []int
+	live map[int]bool
+}
+
+//NewIDAllocator creates an allocator with no ids in use
+func NewIDAllocator() *IDAllocator {
+	return &IDAllocator{
+		next: 1,
+		free: make([]int, 0),
+		live: make(map[int]bool),
+	}
+}
+
+//Acquire gets an id that is not in use, reusing a released one if there are any
+func (alloc *IDAllocator) Acquire() int {
+	var id int
+	if len(alloc.free) > 0 {
+		id = alloc.free[0]
+		alloc.free = alloc.free[1:]
+	} else {
+		id = alloc.next
+		alloc.next++
+	}
+
+	alloc.live[id] = true
+	return id
+}
+
+//Release returns the id so it can be reused. Releasing an id that is not in use has no effect, so an id is never handed out twice.
+func (alloc *IDAllocator) Release(id int) {
+	if !alloc.live[id] {
+		return
+	}
+	delete(alloc.live, id)
+
+	//Keep the free list sorted so the lowest id is reused first
+	i := sort.SearchInts(alloc.free, id)
+	alloc.free = append(alloc.free, 0)
+	copy(alloc.free[i+1:], alloc.free[i:])
+	alloc.free[i] = id
+}
+
+//IsLive checks if the id is currently in use
+func (alloc *IDAllocator) IsLive(id int) bool { return alloc.live[id] }
+
+//Count is the number of ids currently in use
+func (alloc *IDAllocator) Count() int { return len(alloc.live) }
+
+//Reset releases every id, so the next id acquired is 1 again
+func (alloc *IDAllocator) Reset() {
+	alloc.next = 1
+	alloc.free = alloc.free[:0]
+	alloc.live = make(map[int]bool)
+}
@@ -0,0 +1,67 @@
+package raylib
+
+import "testing"
+
+func TestIDAllocatorRecycling(t *testing.T) {
+	alloc := NewIDAllocator()
+
+	for expected := 1; expected <= 5; expected++ {
+		if id := alloc.Acquire(); id != expected {
+			t.Fatalf("Acquire() = %d, want %d", id, expected)
+		}
+	}
+
+	alloc.Release(4)
+	alloc.Release(2)
+	if alloc.IsLive(2) || alloc.IsLive(4) || !alloc.IsLive(3) {
+		t.Errorf("IsLive() after releasing 2 and 4 = %v, %v, %v, want false, true, false", alloc.IsLive(2), alloc.IsLive(3), alloc.IsLive(4))
+	}
+	if count := alloc.Count(); count != 3 {
+		t.Errorf("Count() = %d, want 3", count)
+	}
+
+	//Released ids come back lowest first, then new ids continue on
+	for _, expected := range []int{2, 4, 6} {
+		if id := alloc.Acquire(); id != expected {
+			t.Errorf("Acquire() after releasing = %d, want %d", id, expected)
+		}
+	}
+	if count := alloc.Count(); count != 6 {
+		t.Errorf("Count() = %d, want 6", count)
+	}
+}
+
+func TestIDAllocatorDoubleRelease(t *testing.T) {
+	alloc := NewIDAllocator()
+	id := alloc.Acquire()
+	alloc.Acquire()
+
+	alloc.Release(id)
+	alloc.Release(id)
+	alloc.Release(100)
+
+	first, second := alloc.Acquire(), alloc.Acquire()
+	if first == second {
+		t.Errorf("Acquire() handed out %d twice after it was released twice", first)
+	}
+	if first != 1 || second != 3 {
+		t.Errorf("Acquire() = %d and %d, want 1 and 3", first, second)
+	}
+}
+
+func TestIDAllocatorReset(t *testing.T) {
+	alloc := NewIDAllocator()
+	alloc.Acquire()
+	alloc.Release(alloc.Acquire())
+	alloc.Reset()
+
+	if count := alloc.Count(); count != 0 {
+		t.Errorf("Count() after Reset() = %d, want 0", count)
+	}
+	if id := alloc.Acquire(); id != 1 {
+		t.Errorf("Acquire() after Reset() = %d, want 1", id)
+	}
+	if id := alloc.Acquire(); id != 2 {
+		t.Errorf("Acquire() after Reset() = %d, want 2, as the free list should be cleared", id)
+	}
+}
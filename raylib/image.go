@@ -4,7 +4,14 @@ package raylib
 //#import <stdlib.h>
 import "C"
 import (
+	"fmt"
 	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"sort"
+	"strings"
 	"unsafe"
 )
 
@@ -35,17 +42,635 @@ func newImageFromPointer(ptr unsafe.Pointer) *Image {
 //LoadImageFromGo Creates a new image from a Go Image
 func LoadImageFromGo(img image.Image) *Image {
 	size := img.Bounds().Size()
-	pixels := make([]Color, size.X*size.Y)
+	return LoadImageEx(goImagePixels(img), int32(size.X), int32(size.Y))
+}
+
+//goImagePixels converts a Go image into straight alpha RGBA pixels.
+// The common image types are copied directly, and everything else is converted pixel by pixel.
+func goImagePixels(img image.Image) []Color {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	pixels := make([]Color, width*height)
+
+	switch src := img.(type) {
+	case *image.NRGBA:
+		for y := 0; y < height; y++ {
+			row := src.Pix[y*src.Stride:]
+			for x := 0; x < width; x++ {
+				pixels[x+y*width] = NewColor(row[x*4], row[x*4+1], row[x*4+2], row[x*4+3])
+			}
+		}
+
+	case *image.Gray:
+		for y := 0; y < height; y++ {
+			row := src.Pix[y*src.Stride:]
+			for x := 0; x < width; x++ {
+				pixels[x+y*width] = NewColor(row[x], row[x], row[x], 255)
+			}
+		}
+
+	case *image.Paletted:
+		palette := make([]Color, len(src.Palette))
+		for i, c := range src.Palette {
+			nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+			palette[i] = NewColor(nrgba.R, nrgba.G, nrgba.B, nrgba.A)
+		}
+		for y := 0; y < height; y++ {
+			row := src.Pix[y*src.Stride:]
+			for x := 0; x < width; x++ {
+				if index := int(row[x]); index < len(palette) {
+					pixels[x+y*width] = palette[index]
+				}
+			}
+		}
+
+	default:
+		//This also handles *image.RGBA, which is premultiplied and needs converting to straight alpha
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				nrgba := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+				pixels[x+y*width] = NewColor(nrgba.R, nrgba.G, nrgba.B, nrgba.A)
+			}
+		}
+	}
+
+	return pixels
+}
+
+//clampImageRect clamps the rectangle to whole pixels within an image of the size. Returns false if there is nothing left.
+func clampImageRect(rec Rectangle, width, height int32) (Rectangle, bool) {
+	x0, y0 := int32(math.Floor(float64(rec.X))), int32(math.Floor(float64(rec.Y)))
+	x1, y1 := int32(math.Floor(float64(rec.X+rec.Width))), int32(math.Floor(float64(rec.Y+rec.Height)))
+	if x0 < 0 {
+		x0 = 0
+	}
+	if y0 < 0 {
+		y0 = 0
+	}
+	if x1 > width {
+		x1 = width
+	}
+	if y1 > height {
+		y1 = height
+	}
+
+	if x1 <= x0 || y1 <= y0 {
+		return Rectangle{}, false
+	}
+	return NewRectangle(float32(x0), float32(y0), float32(x1-x0), float32(y1-y0)), true
+}
+
+//ToASCII converts the image into ASCII art that is width characters wide. Each character is picked
+// from the charset based on the brightness of the pixel, with the charset ordered from darkest to brightest.
+// Rows are halved as terminal characters are roughly twice as tall as they are wide.
+// If the charset is empty, " .:-=+*#%@" is used.
+func (image *Image) ToASCII(width int, charset string) string {
+	if width <= 0 || image.Width <= 0 || image.Height <= 0 {
+		return ""
+	}
+
+	if charset == "" {
+		charset = " .:-=+*#%@"
+	}
+
+	chars := []rune(charset)
+	pixels := image.GetPixels()
+	imgWidth, imgHeight := int(image.Width), int(image.Height)
+
+	//Calculate the rows, halving them to keep the aspect ratio
+	height := int(float32(width) * float32(imgHeight) / float32(imgWidth) / 2)
+	if height < 1 {
+		height = 1
+	}
+
+	var sb strings.Builder
+	sb.Grow((width + 1) * height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			color := pixels[(x*imgWidth/width)+(y*imgHeight/height)*imgWidth]
+
+			//Perceived brightness, faded out by the alpha
+			brightness := (0.299*float32(color.R) + 0.587*float32(color.G) + 0.114*float32(color.B)) / 255
+			brightness *= float32(color.A) / 255
+
+			index := int(brightness*float32(len(chars)-1) + 0.5)
+			sb.WriteRune(chars[index])
+		}
+		sb.WriteRune('\n')
+	}
+
+	return sb.String()
+}
+
+//MakeSeamless creates a new image with the opposite edges blended together so it can be tiled without visible seams.
+// This blends the image with a copy of itself offset by half its size, favouring the offset copy towards the edges.
+func (image *Image) MakeSeamless() *Image {
+	width, height := int(image.Width), int(image.Height)
+	pixels := image.GetPixels()
+	result := make([]Color, len(pixels))
+
+	halfWidth := float32(width-1) / 2
+	halfHeight := float32(height-1) / 2
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			original := pixels[x+y*width]
+			offset := pixels[(x+width/2)%width+((y+height/2)%height)*width]
+
+			//How close we are to the edge, 0 in the center and 1 at the edge
+			var dx, dy float32
+			if halfWidth > 0 {
+				dx = float32(math.Abs(float64((float32(x) - halfWidth) / halfWidth)))
+			}
+			if halfHeight > 0 {
+				dy = float32(math.Abs(float64((float32(y) - halfHeight) / halfHeight)))
+			}
+
+			//Only start blending in the outer half, keeping the center (where the offset copy has its seams) untouched
+			weight := Clamp32((float32(math.Max(float64(dx), float64(dy)))-0.5)*2, 0, 1)
+			result[x+y*width] = original.Lerp(offset, weight)
+		}
+	}
+
+	return LoadImageEx(result, image.Width, image.Height)
+}
+
+//IsTileable checks if the opposite edges of the image match, with each colour channel within the tolerance.
+// Images without any pixels have no edges to match, so are never tileable.
+func (image *Image) IsTileable(tolerance uint8) bool {
+	if image.Width <= 0 || image.Height <= 0 {
+		return false
+	}
+
+	width, height := int(image.Width), int(image.Height)
+	pixels := image.GetPixels()
+
+	for y := 0; y < height; y++ {
+		if !colorWithinTolerance(pixels[y*width], pixels[width-1+y*width], tolerance) {
+			return false
+		}
+	}
+
+	for x := 0; x < width; x++ {
+		if !colorWithinTolerance(pixels[x], pixels[x+(height-1)*width], tolerance) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//colorWithinTolerance checks if every channel of the two colours are within the tolerance of each other
+func colorWithinTolerance(a, b Color, tolerance uint8) bool {
+	return channelDifference(a.R, b.R) <= tolerance &&
+		channelDifference(a.G, b.G) <= tolerance &&
+		channelDifference(a.B, b.B) <= tolerance &&
+		channelDifference(a.A, b.A) <= tolerance
+}
+
+//channelDifference gets the absolute difference between two channels
+func channelDifference(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+//DrawTextMultiline draws text (custom sprite font) within an image, breaking it onto new lines on every '\n'.
+// Each line is placed 1.5 times the font size below the previous, matching how raylib lays out text on screen.
+func (dst *Image) DrawTextMultiline(text string, position Vector2, font *Font, fontSize, spacing float32, tint Color) {
+	for _, line := range multilineText(text, position, fontSize) {
+		dst.DrawTextEx(line.position, font, line.text, fontSize, spacing, tint)
+	}
+}
+
+//textLine is a single line of multiline text and where it is drawn
+type textLine struct {
+	text     string
+	position Vector2
+}
+
+//multilineText splits the text into lines, skipping empty lines but keeping the space they take up
+func multilineText(text string, position Vector2, fontSize float32) []textLine {
+	lineHeight := fontSize * 1.5
+	lines := make([]textLine, 0)
+	for i, line := range strings.Split(text, "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		lines = append(lines, textLine{text: line, position: NewVector2(position.X, position.Y+lineHeight*float32(i))})
+	}
+	return lines
+}
+
+//SplitChannels splits the image into a grayscale image for each of its red, green, blue and alpha channels.
+// This is useful for channel-packing workflows, such as storing several masks in a single texture.
+func (image *Image) SplitChannels() (r, g, b, a *Image) {
+	pixels := image.GetPixels()
+	channels := [4][]Color{}
+	for c := range channels {
+		channels[c] = make([]Color, len(pixels))
+	}
+
+	for i, p := range pixels {
+		channels[0][i] = NewColor(p.R, p.R, p.R, 255)
+		channels[1][i] = NewColor(p.G, p.G, p.G, 255)
+		channels[2][i] = NewColor(p.B, p.B, p.B, 255)
+		channels[3][i] = NewColor(p.A, p.A, p.A, 255)
+	}
+
+	return LoadImageEx(channels[0], image.Width, image.Height),
+		LoadImageEx(channels[1], image.Width, image.Height),
+		LoadImageEx(channels[2], image.Width, image.Height),
+		LoadImageEx(channels[3], image.Width, image.Height)
+}
+
+//MergeChannels creates a new image using the red channel of each image as the red, green, blue and alpha channels.
+// This is the reverse of SplitChannels. All the images must have the same dimensions.
+func MergeChannels(r, g, b, a *Image) (*Image, error) {
+	for _, img := range []*Image{g, b, a} {
+		if img.Width != r.Width || img.Height != r.Height {
+			return nil, fmt.Errorf("channel dimensions do not match: %dx%d and %dx%d", r.Width, r.Height, img.Width, img.Height)
+		}
+	}
+
+	rp, gp, bp, ap := r.GetPixels(), g.GetPixels(), b.GetPixels(), a.GetPixels()
+	pixels := make([]Color, len(rp))
+	for i := range pixels {
+		pixels[i] = NewColor(rp[i].R, gp[i].R, bp[i].R, ap[i].R)
+	}
+
+	return LoadImageEx(pixels, r.Width, r.Height), nil
+}
+
+//AddPadding creates a new image with a border of padding pixels around every side, filled with the colour.
+// The original image is kept centered within the new one.
+func (image *Image) AddPadding(padding int, fill Color) *Image {
+	if padding < 0 {
+		padding = 0
+	}
+
+	width, height := int(image.Width), int(image.Height)
+	newWidth, newHeight := width+padding*2, height+padding*2
+	src := image.GetPixels()
+	pixels := make([]Color, newWidth*newHeight)
+
+	for i := range pixels {
+		pixels[i] = fill
+	}
+
+	for y := 0; y < height; y++ {
+		copy(pixels[padding+(y+padding)*newWidth:], src[y*width:(y+1)*width])
+	}
+
+	return LoadImageEx(pixels, int32(newWidth), int32(newHeight))
+}
+
+//ExtrudeEdges creates a new image with the edge pixels repeated outwards on every side.
+// Used on sprites in an atlas, this stops neighbouring sprites bleeding in when sampling at non-integer UVs.
+func (image *Image) ExtrudeEdges(pixels int) *Image {
+	if pixels < 0 {
+		pixels = 0
+	}
+
+	width, height := int(image.Width), int(image.Height)
+	newWidth, newHeight := width+pixels*2, height+pixels*2
+	src := image.GetPixels()
+	dst := make([]Color, newWidth*newHeight)
+
+	if width > 0 && height > 0 {
+		for y := 0; y < newHeight; y++ {
+			sy := int(Clamp(float64(y-pixels), 0, float64(height-1)))
+			for x := 0; x < newWidth; x++ {
+				sx := int(Clamp(float64(x-pixels), 0, float64(width-1)))
+				dst[x+y*newWidth] = src[sx+sy*width]
+			}
+		}
+	}
 
-	for y := 0; y < size.Y; y++ {
-		for x := 0; x < size.X; x++ {
-			color := img.At(x, y)
-			r, g, b, a := color.RGBA()
-			pixels[x+y*size.X] = NewColor(uint8(r), uint8(g), uint8(b), uint8(a))
+	return LoadImageEx(dst, int32(newWidth), int32(newHeight))
+}
+
+//DominantColors finds up to n colours that best represent the image, ordered from the most to least common.
+// The colours are found by median cut, which always gives the same result for the same image. Transparent pixels are ignored.
+func (image *Image) DominantColors(n int) []Color {
+	pixels := make([]Color, 0, image.Width*image.Height)
+	for _, pixel := range image.GetPixels() {
+		if pixel.A > 0 {
+			pixels = append(pixels, pixel)
+		}
+	}
+
+	buckets := medianCut(pixels, n)
+	sort.SliceStable(buckets, func(i, j int) bool { return len(buckets[i]) > len(buckets[j]) })
+
+	colors := make([]Color, len(buckets))
+	for i, bucket := range buckets {
+		colors[i] = averageColor(bucket)
+	}
+	return colors
+}
+
+//QuantizeColors reduces the pixels to at most maxColors colours, such as before encoding a gif, and returns the remapped pixels.
+// The palette is found by median cut and each pixel is replaced with its nearest palette colour, so the result is always the same for the same pixels.
+// If there are already no more than maxColors distinct colours, a copy of the pixels is returned unchanged.
+func QuantizeColors(pixels []Color, maxColors int) []Color {
+	result := make([]Color, len(pixels))
+	copy(result, pixels)
+
+	distinct := make(map[Color]bool)
+	for _, pixel := range pixels {
+		distinct[pixel] = true
+		if len(distinct) > maxColors {
+			break
+		}
+	}
+	if len(distinct) <= maxColors || maxColors <= 0 {
+		return result
+	}
+
+	//medianCut sorts the buckets, so work on a copy
+	sorted := make([]Color, len(pixels))
+	copy(sorted, pixels)
+	buckets := medianCut(sorted, maxColors)
+
+	palette := make([]Color, len(buckets))
+	for i, bucket := range buckets {
+		palette[i] = averageColor(bucket)
+	}
+
+	//Images usually repeat colours a lot, so remember what each one maps to
+	mapped := make(map[Color]Color)
+	for i, pixel := range result {
+		if color, ok := mapped[pixel]; ok {
+			result[i] = color
+			continue
+		}
+
+		color := nearestColor(pixel, palette)
+		mapped[pixel] = color
+		result[i] = color
+	}
+
+	return result
+}
+
+//nearestColor finds the colour in the palette closest to the colour. Ties go to the earliest colour in the palette.
+func nearestColor(color Color, palette []Color) Color {
+	best, bestDistance := Color{}, -1
+	for _, candidate := range palette {
+		distance := 0
+		for c := 0; c < 4; c++ {
+			d := int(colorChannel(color, c)) - int(colorChannel(candidate, c))
+			distance += d * d
+		}
+
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+//medianCut splits the pixels into at most n buckets of similar colours.
+// The bucket with the widest range in any channel is repeatedly split in half along that channel.
+func medianCut(pixels []Color, n int) [][]Color {
+	if n <= 0 || len(pixels) == 0 {
+		return [][]Color{}
+	}
+
+	buckets := [][]Color{pixels}
+	for len(buckets) < n {
+		//Find the bucket with the widest channel
+		widest, widestChannel, widestRange := -1, 0, 0
+		for i, bucket := range buckets {
+			if len(bucket) < 2 {
+				continue
+			}
+			channel, size := widestColorChannel(bucket)
+			if size > widestRange {
+				widest, widestChannel, widestRange = i, channel, size
+			}
+		}
+
+		//Every bucket is a single colour, so there is nothing left to split
+		if widest < 0 {
+			break
+		}
+
+		bucket := buckets[widest]
+		sort.SliceStable(bucket, func(i, j int) bool {
+			return colorChannel(bucket[i], widestChannel) < colorChannel(bucket[j], widestChannel)
+		})
+
+		half := len(bucket) / 2
+		buckets[widest] = bucket[:half]
+		buckets = append(buckets, bucket[half:])
+	}
+
+	return buckets
+}
+
+//widestColorChannel finds the red (0), green (1) or blue (2) channel with the largest range in the colours
+func widestColorChannel(colors []Color) (channel int, size int) {
+	for c := 0; c < 3; c++ {
+		min, max := 255, 0
+		for _, color := range colors {
+			v := int(colorChannel(color, c))
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+
+		if max-min > size {
+			channel, size = c, max-min
+		}
+	}
+	return channel, size
+}
+
+//colorChannel gets the red (0), green (1), blue (2) or alpha (3) channel of the colour
+func colorChannel(color Color, channel int) uint8 {
+	switch channel {
+	case 0:
+		return color.R
+	case 1:
+		return color.G
+	case 2:
+		return color.B
+	default:
+		return color.A
+	}
+}
+
+//averageColor gets the mean of every channel of the colours
+func averageColor(colors []Color) Color {
+	if len(colors) == 0 {
+		return Color{}
+	}
+
+	var r, g, b, a int
+	for _, color := range colors {
+		r += int(color.R)
+		g += int(color.G)
+		b += int(color.B)
+		a += int(color.A)
+	}
+
+	count := len(colors)
+	return NewColor(uint8(r/count), uint8(g/count), uint8(b/count), uint8(a/count))
+}
+
+//ExportNinePatch saves the image as a .9.png, adding the one pixel border with the markers for the insets.
+// The insets are the size in pixels of the left (X), top (Y), right (Z) and bottom (W) borders that should not stretch.
+// The area between the borders is marked as both the stretchable area and the content area.
+func (image *Image) ExportNinePatch(path string, insets Vector4) error {
+	width, height := int(image.Width), int(image.Height)
+	left, top, right, bottom := int(insets.X), int(insets.Y), int(insets.Z), int(insets.W)
+
+	if left < 0 || top < 0 || right < 0 || bottom < 0 {
+		return fmt.Errorf("nine patch insets cannot be negative")
+	}
+	if left+right >= width || top+bottom >= height {
+		return fmt.Errorf("nine patch insets %v do not fit within a %dx%d image", insets, width, height)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return png.Encode(file, ninePatchImage(image.GetPixels(), width, height, left, top, right, bottom))
+}
+
+//ninePatchImage creates the nine patch image with the border markers around the pixels
+func ninePatchImage(pixels []Color, width, height, left, top, right, bottom int) *image.NRGBA {
+	result := image.NewNRGBA(image.Rect(0, 0, width+2, height+2))
+	marker := color.NRGBA{A: 255}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			p := pixels[x+y*width]
+			result.SetNRGBA(x+1, y+1, color.NRGBA{R: p.R, G: p.G, B: p.B, A: p.A})
 		}
 	}
 
-	return LoadImageEx(pixels, int32(size.X), int32(size.Y))
+	//Top and bottom rows mark the horizontal stretch and content
+	for x := left; x < width-right; x++ {
+		result.SetNRGBA(x+1, 0, marker)
+		result.SetNRGBA(x+1, height+1, marker)
+	}
+
+	//Left and right columns mark the vertical stretch and content
+	for y := top; y < height-bottom; y++ {
+		result.SetNRGBA(0, y+1, marker)
+		result.SetNRGBA(width+1, y+1, marker)
+	}
+
+	return result
+}
+
+//ImageBlendMode is how the colours of two images are combined when blitting
+type ImageBlendMode int
+
+const (
+	//ImageBlendNormal draws the source over the destination
+	ImageBlendNormal ImageBlendMode = iota
+	//ImageBlendAdditive adds the source to the destination, brightening it
+	ImageBlendAdditive
+	//ImageBlendMultiply multiplies the source with the destination, darkening it
+	ImageBlendMultiply
+	//ImageBlendScreen inverts, multiplies and inverts again, brightening the destination
+	ImageBlendScreen
+)
+
+//Blit creates a new image with the source drawn over this image at the position, combining them with the blend mode.
+// The blend is faded by the alpha of the source, and any of the source outside of this image is ignored.
+func (dst *Image) Blit(src *Image, x, y int, mode ImageBlendMode) *Image {
+	dstWidth, dstHeight := int(dst.Width), int(dst.Height)
+	srcWidth, srcHeight := int(src.Width), int(src.Height)
+	pixels := dst.GetPixels()
+	srcPixels := src.GetPixels()
+
+	for sy := 0; sy < srcHeight; sy++ {
+		dy := y + sy
+		if dy < 0 || dy >= dstHeight {
+			continue
+		}
+
+		for sx := 0; sx < srcWidth; sx++ {
+			dx := x + sx
+			if dx < 0 || dx >= dstWidth {
+				continue
+			}
+
+			pixels[dx+dy*dstWidth] = blendColors(pixels[dx+dy*dstWidth], srcPixels[sx+sy*srcWidth], mode)
+		}
+	}
+
+	return LoadImageEx(pixels, dst.Width, dst.Height)
+}
+
+//blendColors combines the source colour onto the destination using the blend mode
+func blendColors(dst, src Color, mode ImageBlendMode) Color {
+	d, s := dst.Normalize(), src.Normalize()
+
+	blend := func(a, b float32) float32 {
+		switch mode {
+		case ImageBlendAdditive:
+			return Clamp32(a+b, 0, 1)
+		case ImageBlendMultiply:
+			return a * b
+		case ImageBlendScreen:
+			return 1 - (1-a)*(1-b)
+		default:
+			return b
+		}
+	}
+
+	//Fade the blended result by the source alpha, rounding so unchanged channels stay exact
+	toByte := func(v float32) uint8 { return uint8(Clamp32(v, 0, 1)*255 + 0.5) }
+	return NewColor(
+		toByte(d.X+(blend(d.X, s.X)-d.X)*s.W),
+		toByte(d.Y+(blend(d.Y, s.Y)-d.Y)*s.W),
+		toByte(d.Z+(blend(d.Z, s.Z)-d.Z)*s.W),
+		toByte(s.W+d.W*(1-s.W)),
+	)
+}
+
+//ImageDiff compares two images of the same size, useful for checking rendering against a known good image.
+// The diff image holds the absolute difference of each channel, with a solid alpha so it can be viewed directly.
+// The mismatch is the ratio of pixels that are not identical, from 0 for matching images to 1 when every pixel differs.
+func ImageDiff(a, b *Image) (diff *Image, mismatch float64, err error) {
+	if a.Width != b.Width || a.Height != b.Height {
+		return nil, 0, fmt.Errorf("image dimensions do not match: %dx%d and %dx%d", a.Width, a.Height, b.Width, b.Height)
+	}
+
+	ap, bp := a.GetPixels(), b.GetPixels()
+	pixels := make([]Color, len(ap))
+	mismatched := 0
+
+	for i := range pixels {
+		pixels[i] = NewColor(
+			channelDifference(ap[i].R, bp[i].R),
+			channelDifference(ap[i].G, bp[i].G),
+			channelDifference(ap[i].B, bp[i].B),
+			255)
+
+		if ap[i] != bp[i] {
+			mismatched++
+		}
+	}
+
+	if len(pixels) > 0 {
+		mismatch = float64(mismatched) / float64(len(pixels))
+	}
+
+	return LoadImageEx(pixels, a.Width, a.Height), mismatch, nil
 }
 
 //void ExportImage(Image image, const char *fileName);
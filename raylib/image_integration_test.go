@@ -0,0 +1,77 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import "testing"
+
+func TestDrawTextMultiline(t *testing.T) {
+	requireWindow(t)
+
+	var pixels []Color
+	onMainThread(func() {
+		image := GenImageColor(64, 64, Black)
+		defer image.Unload()
+
+		//The second line is drawn 30 pixels down, below where the first line ends
+		image.DrawTextMultiline("\nWWW", NewVector2(0, 0), GetFontDefault(), 20, 2, White)
+		pixels = image.GetPixels()
+	})
+
+	lit := func(y0, y1 int) bool {
+		for y := y0; y < y1; y++ {
+			for x := 0; x < 64; x++ {
+				if pixels[x+y*64] != Black {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if lit(0, 30) {
+		t.Error("pixels were drawn on the empty first line")
+	}
+	if !lit(30, 50) {
+		t.Error("no pixels were drawn on the second line")
+	}
+}
+
+func TestImageDrawText(t *testing.T) {
+	requireWindow(t)
+
+	var drawn, empty []Color
+	onMainThread(func() {
+		image := GenImageColor(64, 32, Black)
+		defer image.Unload()
+		image.DrawText(NewVector2(4, 4), "HI", 20, White)
+		drawn = image.GetPixels()
+
+		untouched := GenImageColor(64, 32, Black)
+		defer untouched.Unload()
+		untouched.DrawText(NewVector2(4, 4), "", 20, White)
+		untouched.DrawText(NewVector2(4, 4), "HI", 0, White)
+		empty = untouched.GetPixels()
+	})
+
+	//The text is composited in white over the black, so some pixels are lit and the corner is not
+	lit := 0
+	for _, p := range drawn {
+		if p.R > 128 {
+			lit++
+		}
+	}
+	if lit == 0 {
+		t.Error("no pixels were drawn by DrawText()")
+	}
+	if corner := drawn[0]; corner != Black {
+		t.Errorf("corner pixel outside of the text = %v, want black", corner)
+	}
+
+	for i, p := range empty {
+		if p != Black {
+			t.Errorf("pixel %d after DrawText() with empty text or size = %v, want black", i, p)
+			break
+		}
+	}
+}
@@ -0,0 +1,642 @@
+package raylib
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//loadTestImage creates an image with each pixel set by the function, unloading it when the test finishes
+func loadTestImage(t *testing.T, width, height int, pixel func(x, y int) Color) *Image {
+	t.Helper()
+	pixels := make([]Color, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			pixels[x+y*width] = pixel(x, y)
+		}
+	}
+
+	image := LoadImageEx(pixels, int32(width), int32(height))
+	t.Cleanup(image.Unload)
+	return image
+}
+
+//grayGradient goes from black on the left to white on the right
+func grayGradient(width int) func(x, y int) Color {
+	return func(x, y int) Color {
+		value := uint8(x * 255 / (width - 1))
+		return NewColor(value, value, value, 255)
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	image := loadTestImage(t, 8, 4, grayGradient(8))
+
+	//Rows are halved, so an 8x4 image at 8 characters wide is 2 rows
+	ascii := image.ToASCII(8, ".#")
+	if ascii != "....####\n....####\n" {
+		t.Errorf("ToASCII(8) =\n%s\nwant dark on the left and bright on the right", ascii)
+	}
+
+	//The default charset runs from a space to @. At half the width every other column is sampled, so white is skipped.
+	lines := strings.Split(strings.TrimSuffix(image.ToASCII(4, ""), "\n"), "\n")
+	if len(lines) != 1 || lines[0] != " -+%" {
+		t.Errorf("ToASCII(4) = %q, want one row of \" -+%%\"", lines)
+	}
+
+	//Wide images still get at least one row
+	if lines := strings.Count(image.ToASCII(1, ""), "\n"); lines != 1 {
+		t.Errorf("ToASCII(1) has %d rows, want 1", lines)
+	}
+	if ascii := image.ToASCII(0, ""); ascii != "" {
+		t.Errorf("ToASCII(0) = %q, want empty", ascii)
+	}
+}
+
+func TestIsTileable(t *testing.T) {
+	solid := loadTestImage(t, 4, 4, func(x, y int) Color { return Red })
+	if !solid.IsTileable(0) {
+		t.Error("a solid image is not tileable")
+	}
+
+	//The left and right edges of the gradient are black and white
+	gradient := loadTestImage(t, 8, 4, grayGradient(8))
+	if gradient.IsTileable(254) {
+		t.Error("a black to white gradient is tileable with a tolerance of 254")
+	}
+	if !gradient.IsTileable(255) {
+		t.Error("a black to white gradient is not tileable with a tolerance of 255")
+	}
+
+	//Only the top and bottom edges differ
+	stripes := loadTestImage(t, 4, 4, func(x, y int) Color {
+		if y == 3 {
+			return Blue
+		}
+		return Red
+	})
+	if stripes.IsTileable(10) {
+		t.Error("an image with a different bottom row is tileable")
+	}
+
+	//Empty images are rejected before reading any pixels
+	for _, empty := range []*Image{{Width: 0, Height: 4}, {Width: 4, Height: 0}, {Width: -1, Height: -1}} {
+		if empty.IsTileable(255) {
+			t.Errorf("a %dx%d image is tileable", empty.Width, empty.Height)
+		}
+	}
+}
+
+func TestMakeSeamless(t *testing.T) {
+	gradient := loadTestImage(t, 8, 8, grayGradient(8))
+	seamless := gradient.MakeSeamless()
+	t.Cleanup(seamless.Unload)
+
+	if seamless.Width != 8 || seamless.Height != 8 {
+		t.Fatalf("MakeSeamless() is %dx%d, want 8x8", seamless.Width, seamless.Height)
+	}
+
+	//The jump from the right edge back to the left is reduced to a single step of the gradient
+	pixels := seamless.GetPixels()
+	for y := 0; y < 8; y++ {
+		if difference := channelDifference(pixels[y*8].R, pixels[7+y*8].R); difference > 255/7+1 {
+			t.Errorf("row %d wraps from %v to %v, a jump of %d", y, pixels[7+y*8], pixels[y*8], difference)
+		}
+	}
+	if !seamless.IsTileable(255/7 + 1) {
+		t.Error("MakeSeamless() is not tileable within a step of the gradient")
+	}
+
+	//The center is left untouched
+	original := gradient.GetPixels()
+	for _, i := range []int{3 + 3*8, 4 + 4*8} {
+		if pixels[i] != original[i] {
+			t.Errorf("center pixel %d = %v, want it unchanged as %v", i, pixels[i], original[i])
+		}
+	}
+}
+
+func TestMultilineText(t *testing.T) {
+	lines := multilineText("first\nsecond\n\nfourth\n", NewVector2(10, 20), 20)
+
+	//Empty lines are skipped but still move the following lines down
+	expected := []textLine{
+		{"first", NewVector2(10, 20)},
+		{"second", NewVector2(10, 50)},
+		{"fourth", NewVector2(10, 110)},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("multilineText() = %v, want %v", lines, expected)
+	}
+	for i := range expected {
+		if lines[i].text != expected[i].text || !vector2NearlyEqual(lines[i].position, expected[i].position) {
+			t.Errorf("line %d = %v, want %v", i, lines[i], expected[i])
+		}
+	}
+
+	if lines := multilineText("", NewVector2(0, 0), 10); len(lines) != 0 {
+		t.Errorf("multilineText() of no text = %v, want no lines", lines)
+	}
+}
+
+func TestSplitMergeChannels(t *testing.T) {
+	original := loadTestImage(t, 3, 2, func(x, y int) Color {
+		return NewColor(uint8(x*80), uint8(y*200), uint8(10+x+y), uint8(255-x*50))
+	})
+
+	r, g, b, a := original.SplitChannels()
+	for _, channel := range []*Image{r, g, b, a} {
+		t.Cleanup(channel.Unload)
+	}
+
+	//Each channel is an opaque grayscale image of its values
+	expected := original.GetPixels()
+	for i, p := range r.GetPixels() {
+		if want := NewColor(expected[i].R, expected[i].R, expected[i].R, 255); p != want {
+			t.Errorf("red channel pixel %d = %v, want %v", i, p, want)
+		}
+	}
+	for i, p := range a.GetPixels() {
+		if want := NewColor(expected[i].A, expected[i].A, expected[i].A, 255); p != want {
+			t.Errorf("alpha channel pixel %d = %v, want %v", i, p, want)
+		}
+	}
+
+	merged, err := MergeChannels(r, g, b, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(merged.Unload)
+
+	for i, p := range merged.GetPixels() {
+		if p != expected[i] {
+			t.Errorf("merged pixel %d = %v, want %v", i, p, expected[i])
+		}
+	}
+}
+
+func TestMergeChannelsMismatched(t *testing.T) {
+	small := loadTestImage(t, 2, 2, func(x, y int) Color { return White })
+	large := loadTestImage(t, 3, 2, func(x, y int) Color { return White })
+	if merged, err := MergeChannels(small, small, large, small); err == nil || merged != nil {
+		t.Errorf("MergeChannels() of mismatched sizes = %v, %v, want an error", merged, err)
+	}
+}
+
+func TestImageDiff(t *testing.T) {
+	a := loadTestImage(t, 2, 2, func(x, y int) Color { return NewColor(100, 100, 100, 255) })
+	same := loadTestImage(t, 2, 2, func(x, y int) Color { return NewColor(100, 100, 100, 255) })
+
+	diff, mismatch, err := ImageDiff(a, same)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(diff.Unload)
+	if mismatch != 0 {
+		t.Errorf("mismatch of identical images = %v, want 0", mismatch)
+	}
+	for i, p := range diff.GetPixels() {
+		if p != Black {
+			t.Errorf("diff pixel %d of identical images = %v, want black", i, p)
+		}
+	}
+
+	//One pixel is brighter, and another only differs in alpha
+	b := loadTestImage(t, 2, 2, func(x, y int) Color {
+		switch {
+		case x == 1 && y == 0:
+			return NewColor(150, 90, 100, 255)
+		case x == 0 && y == 1:
+			return NewColor(100, 100, 100, 10)
+		}
+		return NewColor(100, 100, 100, 255)
+	})
+
+	diff, mismatch, err = ImageDiff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(diff.Unload)
+	if mismatch != 0.5 {
+		t.Errorf("mismatch with 2 of 4 pixels different = %v, want 0.5", mismatch)
+	}
+	if p := diff.GetPixels()[1]; p != NewColor(50, 10, 0, 255) {
+		t.Errorf("diff of the changed pixel = %v, want the absolute difference with a solid alpha", p)
+	}
+}
+
+func TestImageDiffMismatchedSize(t *testing.T) {
+	a := loadTestImage(t, 2, 2, func(x, y int) Color { return White })
+	b := loadTestImage(t, 2, 3, func(x, y int) Color { return White })
+	if diff, _, err := ImageDiff(a, b); err == nil || diff != nil {
+		t.Errorf("ImageDiff() of different sizes = %v, %v, want an error", diff, err)
+	}
+}
+
+//cornerColors gives each pixel of a 2x2 image its own colour
+func cornerColors(x, y int) Color {
+	return []Color{Red, Green, Blue, Yellow}[x+y*2]
+}
+
+func TestAddPadding(t *testing.T) {
+	image := loadTestImage(t, 2, 2, cornerColors)
+	padded := image.AddPadding(2, Blank)
+	t.Cleanup(padded.Unload)
+
+	if padded.Width != 6 || padded.Height != 6 {
+		t.Fatalf("AddPadding(2) of a 2x2 image is %dx%d, want 6x6", padded.Width, padded.Height)
+	}
+
+	pixels := padded.GetPixels()
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 6; x++ {
+			want := Blank
+			if x >= 2 && x < 4 && y >= 2 && y < 4 {
+				want = cornerColors(x-2, y-2)
+			}
+			if pixels[x+y*6] != want {
+				t.Errorf("padded pixel %d, %d = %v, want %v", x, y, pixels[x+y*6], want)
+			}
+		}
+	}
+
+	unpadded := image.AddPadding(-1, Blank)
+	t.Cleanup(unpadded.Unload)
+	if unpadded.Width != 2 || unpadded.Height != 2 {
+		t.Errorf("AddPadding(-1) is %dx%d, want the original 2x2", unpadded.Width, unpadded.Height)
+	}
+}
+
+func TestExtrudeEdges(t *testing.T) {
+	image := loadTestImage(t, 2, 2, cornerColors)
+	extruded := image.ExtrudeEdges(2)
+	t.Cleanup(extruded.Unload)
+
+	if extruded.Width != 6 || extruded.Height != 6 {
+		t.Fatalf("ExtrudeEdges(2) of a 2x2 image is %dx%d, want 6x6", extruded.Width, extruded.Height)
+	}
+
+	//Every pixel outside repeats the nearest edge pixel, so each quarter is a single colour
+	pixels := extruded.GetPixels()
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 6; x++ {
+			if want := cornerColors(x/3, y/3); pixels[x+y*6] != want {
+				t.Errorf("extruded pixel %d, %d = %v, want %v", x, y, pixels[x+y*6], want)
+			}
+		}
+	}
+}
+
+func TestBlendColors(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src Color
+		mode     ImageBlendMode
+		want     Color
+	}{
+		{"normal", NewColor(100, 50, 200, 255), NewColor(10, 20, 30, 255), ImageBlendNormal, NewColor(10, 20, 30, 255)},
+		{"additive", NewColor(100, 50, 200, 255), NewColor(100, 250, 100, 255), ImageBlendAdditive, NewColor(200, 255, 255, 255)},
+		{"multiply", NewColor(255, 128, 0, 255), NewColor(128, 128, 255, 255), ImageBlendMultiply, NewColor(128, 64, 0, 255)},
+		{"screen", NewColor(128, 0, 255, 255), NewColor(128, 0, 0, 255), ImageBlendScreen, NewColor(192, 0, 255, 255)},
+		{"half alpha additive", NewColor(100, 100, 100, 255), NewColor(100, 0, 0, 128), ImageBlendAdditive, NewColor(150, 100, 100, 255)},
+		{"transparent multiply", NewColor(100, 100, 100, 255), NewColor(0, 0, 0, 0), ImageBlendMultiply, NewColor(100, 100, 100, 255)},
+	}
+
+	for _, test := range tests {
+		if got := blendColors(test.dst, test.src, test.mode); got != test.want {
+			t.Errorf("%s: blendColors(%v, %v) = %v, want %v", test.name, test.dst, test.src, got, test.want)
+		}
+	}
+}
+
+func TestBlit(t *testing.T) {
+	dst := loadTestImage(t, 2, 2, func(x, y int) Color { return White })
+	src := loadTestImage(t, 1, 1, func(x, y int) Color { return NewColor(128, 0, 255, 255) })
+
+	blitted := dst.Blit(src, 1, 1, ImageBlendMultiply)
+	t.Cleanup(blitted.Unload)
+	pixels := blitted.GetPixels()
+	for i, want := range []Color{White, White, White, NewColor(128, 0, 255, 255)} {
+		if pixels[i] != want {
+			t.Errorf("pixel %d after Blit() = %v, want %v", i, pixels[i], want)
+		}
+	}
+
+	//The source is entirely outside of the destination, so nothing changes
+	outside := dst.Blit(src, 2, -1, ImageBlendAdditive)
+	t.Cleanup(outside.Unload)
+	for i, p := range outside.GetPixels() {
+		if p != White {
+			t.Errorf("pixel %d after Blit() outside the image = %v, want white", i, p)
+		}
+	}
+}
+
+func TestDominantColors(t *testing.T) {
+	//A solid image only has one colour to give, however many are asked for
+	solid := loadTestImage(t, 2, 2, func(x, y int) Color { return Red })
+	if colors := solid.DominantColors(4); len(colors) != 1 || colors[0] != Red {
+		t.Errorf("DominantColors(4) of a solid red image = %v, want [red]", colors)
+	}
+
+	//The transparent pixels are ignored, so only the red and blue halves are left
+	halves := loadTestImage(t, 3, 2, func(x, y int) Color {
+		switch x {
+		case 0:
+			return Red
+		case 1:
+			return Blue
+		}
+		return Blank
+	})
+	colors := halves.DominantColors(2)
+	if len(colors) != 2 || !(colors[0] == Red && colors[1] == Blue || colors[0] == Blue && colors[1] == Red) {
+		t.Errorf("DominantColors(2) of a red and blue image = %v, want red and blue", colors)
+	}
+
+	gradient := loadTestImage(t, 16, 2, grayGradient(16))
+	first, second := gradient.DominantColors(4), gradient.DominantColors(4)
+	if len(first) != 4 {
+		t.Fatalf("DominantColors(4) of a gradient gave %d colours, want 4", len(first))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("DominantColors(4) gave %v then %v, want the same colours each time", first, second)
+			break
+		}
+	}
+
+	empty := loadTestImage(t, 2, 2, func(x, y int) Color { return Blank })
+	if colors := empty.DominantColors(4); len(colors) != 0 {
+		t.Errorf("DominantColors(4) of a transparent image = %v, want none", colors)
+	}
+}
+
+func TestExportNinePatch(t *testing.T) {
+	source := loadTestImage(t, 8, 6, func(x, y int) Color { return Gray })
+	path := filepath.Join(t.TempDir(), "panel.9.png")
+	if err := source.ExportNinePatch(path, NewVector4(1, 2, 3, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	decoded, err := png.Decode(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bounds := decoded.Bounds()
+	if bounds.Dx() != 10 || bounds.Dy() != 8 {
+		t.Fatalf("nine patch of an 8x6 image is %dx%d, want 10x8 with the border", bounds.Dx(), bounds.Dy())
+	}
+
+	//markers finds the first and last marked pixel along the border, relative to the inside of the image
+	markers := func(length int, at func(i int) (x, y int)) (first, last int) {
+		first, last = -1, -1
+		for i := 1; i <= length; i++ {
+			x, y := at(i)
+			if _, _, _, a := decoded.At(x, y).RGBA(); a != 0 {
+				if first < 0 {
+					first = i - 1
+				}
+				last = i - 1
+			}
+		}
+		return first, last
+	}
+
+	for _, row := range []int{0, 7} {
+		first, last := markers(8, func(i int) (int, int) { return i, row })
+		if left, right := first, 8-1-last; left != 1 || right != 3 {
+			t.Errorf("markers in row %d give left and right insets %d and %d, want 1 and 3", row, left, right)
+		}
+	}
+	for _, column := range []int{0, 9} {
+		first, last := markers(6, func(i int) (int, int) { return column, i })
+		if top, bottom := first, 6-1-last; top != 2 || bottom != 1 {
+			t.Errorf("markers in column %d give top and bottom insets %d and %d, want 2 and 1", column, top, bottom)
+		}
+	}
+
+	if r, g, b, _ := decoded.At(4, 4).RGBA(); r>>8 != uint32(Gray.R) || g>>8 != uint32(Gray.G) || b>>8 != uint32(Gray.B) {
+		t.Errorf("inside pixel of the nine patch = %v, want the image colour %v", decoded.At(4, 4), Gray)
+	}
+}
+
+func TestExportNinePatchInvalidInsets(t *testing.T) {
+	source := loadTestImage(t, 4, 4, func(x, y int) Color { return Gray })
+	for _, insets := range []Vector4{NewVector4(-1, 0, 0, 0), NewVector4(2, 0, 2, 0), NewVector4(0, 3, 0, 1)} {
+		if err := source.ExportNinePatch(filepath.Join(t.TempDir(), "invalid.9.png"), insets); err == nil {
+			t.Errorf("ExportNinePatch(%v) of a 4x4 image succeeded, want an error", insets)
+		}
+	}
+}
+
+func TestQuantizeColors(t *testing.T) {
+	gradient := grayGradient(16)
+	pixels := make([]Color, 16)
+	for x := range pixels {
+		pixels[x] = gradient(x, 0)
+	}
+	original := append([]Color(nil), pixels...)
+
+	quantized := QuantizeColors(pixels, 4)
+	if len(quantized) != len(pixels) {
+		t.Fatalf("QuantizeColors() returned %d pixels, want %d", len(quantized), len(pixels))
+	}
+
+	distinct := make(map[Color]bool)
+	for x, pixel := range quantized {
+		distinct[pixel] = true
+		if pixel.R != pixel.G || pixel.G != pixel.B || pixel.A != 255 {
+			t.Errorf("quantized pixel %d = %v, want an opaque gray", x, pixel)
+		}
+		//Each pixel maps to its nearest palette colour, so the gradient never gets darker
+		if x > 0 && pixel.R < quantized[x-1].R {
+			t.Errorf("quantized pixel %d = %v is darker than the pixel before it %v", x, pixel, quantized[x-1])
+		}
+	}
+	if len(distinct) != 4 {
+		t.Errorf("QuantizeColors(4) of a 16 colour gradient gave %d colours, want 4", len(distinct))
+	}
+
+	for x := range pixels {
+		if pixels[x] != original[x] {
+			t.Fatalf("QuantizeColors() modified the source pixel %d", x)
+		}
+	}
+	again := QuantizeColors(pixels, 4)
+	for x := range quantized {
+		if again[x] != quantized[x] {
+			t.Fatalf("QuantizeColors() gave %v then %v, want the same pixels each time", quantized, again)
+		}
+	}
+
+	//There are already few enough colours, so the pixels are copied unchanged
+	unchanged := QuantizeColors(pixels, 16)
+	unchanged[0] = Red
+	if pixels[0] == Red {
+		t.Error("QuantizeColors() with enough colours returned the source slice instead of a copy")
+	}
+	for x := 1; x < len(pixels); x++ {
+		if unchanged[x] != pixels[x] {
+			t.Errorf("QuantizeColors(16) pixel %d = %v, want it unchanged as %v", x, unchanged[x], pixels[x])
+		}
+	}
+}
+
+func TestGoImagePixels(t *testing.T) {
+	rgba := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	rgba.SetRGBA(0, 0, color.RGBA{R: 255, A: 255})
+	rgba.SetRGBA(1, 0, color.RGBA{G: 128, A: 128}) //Premultiplied, so this is full green at half alpha
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	nrgba.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+	nrgba.SetNRGBA(1, 0, color.NRGBA{G: 255, A: 128})
+
+	gray := image.NewGray(image.Rect(0, 0, 2, 1))
+	gray.SetGray(0, 0, color.Gray{Y: 255})
+	gray.SetGray(1, 0, color.Gray{Y: 64})
+
+	paletted := image.NewPaletted(image.Rect(0, 0, 2, 1), color.Palette{color.NRGBA{R: 255, A: 255}, color.NRGBA{G: 255, A: 128}})
+	paletted.SetColorIndex(1, 0, 1)
+
+	//The sub image starts away from the origin, and its rows are narrower than the stride
+	parent := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	parent.SetNRGBA(2, 1, color.NRGBA{R: 255, A: 255})
+	parent.SetNRGBA(3, 1, color.NRGBA{G: 255, A: 128})
+	sub := parent.SubImage(image.Rect(2, 1, 4, 2))
+
+	halfGreen := NewColor(0, 255, 0, 128)
+	tests := []struct {
+		name string
+		img  image.Image
+		want []Color
+	}{
+		{"RGBA", rgba, []Color{NewColor(255, 0, 0, 255), halfGreen}},
+		{"NRGBA", nrgba, []Color{NewColor(255, 0, 0, 255), halfGreen}},
+		{"Gray", gray, []Color{White, NewColor(64, 64, 64, 255)}},
+		{"Paletted", paletted, []Color{NewColor(255, 0, 0, 255), halfGreen}},
+		{"NRGBA sub image", sub, []Color{NewColor(255, 0, 0, 255), halfGreen}},
+	}
+
+	for _, test := range tests {
+		pixels := goImagePixels(test.img)
+		if len(pixels) != len(test.want) {
+			t.Errorf("%s: goImagePixels() gave %d pixels, want %d", test.name, len(pixels), len(test.want))
+			continue
+		}
+		for i := range pixels {
+			if pixels[i] != test.want[i] {
+				t.Errorf("%s: pixel %d = %v, want %v", test.name, i, pixels[i], test.want[i])
+			}
+		}
+	}
+}
+
+//positionColor gives each pixel a colour from its position, so moved pixels can be traced back
+func positionColor(x, y int) Color {
+	return NewColor(uint8(x*60), uint8(y*60), 0, 255)
+}
+
+func TestImageCrop(t *testing.T) {
+	tests := []struct {
+		name          string
+		crop          Rectangle
+		x, y          int
+		width, height int32
+	}{
+		{"inside", NewRectangle(1, 1, 2, 2), 1, 1, 2, 2},
+		{"clamped to the top left", NewRectangle(-2, -1, 4, 3), 0, 0, 2, 2},
+		{"clamped to the bottom right", NewRectangle(3, 2, 10, 10), 3, 2, 1, 2},
+		{"fractional", NewRectangle(0.5, 1.5, 2, 1), 0, 1, 2, 1},
+		{"outside", NewRectangle(5, 5, 2, 2), 0, 0, 4, 4},
+		{"empty", NewRectangle(1, 1, 0, 2), 0, 0, 4, 4},
+	}
+
+	for _, test := range tests {
+		image := loadTestImage(t, 4, 4, positionColor)
+		image.Crop(test.crop)
+		if image.Width != test.width || image.Height != test.height {
+			t.Errorf("%s: Crop(%v) is %dx%d, want %dx%d", test.name, test.crop, image.Width, image.Height, test.width, test.height)
+			continue
+		}
+
+		pixels := image.GetPixels()
+		for y := 0; y < int(test.height); y++ {
+			for x := 0; x < int(test.width); x++ {
+				if want := positionColor(test.x+x, test.y+y); pixels[x+y*int(test.width)] != want {
+					t.Errorf("%s: cropped pixel %d, %d = %v, want %v", test.name, x, y, pixels[x+y*int(test.width)], want)
+				}
+			}
+		}
+	}
+}
+
+func TestImageResize(t *testing.T) {
+	image := loadTestImage(t, 2, 2, cornerColors)
+	image.ResizeNN(4, 6)
+	if image.Width != 4 || image.Height != 6 {
+		t.Fatalf("ResizeNN(4, 6) is %dx%d, want 4x6", image.Width, image.Height)
+	}
+	pixels := image.GetPixels()
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 4; x++ {
+			if want := cornerColors(x/2, y/3); pixels[x+y*4] != want {
+				t.Errorf("resized pixel %d, %d = %v, want %v", x, y, pixels[x+y*4], want)
+			}
+		}
+	}
+
+	smooth := loadTestImage(t, 2, 2, cornerColors)
+	smooth.Resize(3, 5)
+	if smooth.Width != 3 || smooth.Height != 5 {
+		t.Errorf("Resize(3, 5) is %dx%d, want 3x5", smooth.Width, smooth.Height)
+	}
+
+	//Sizes that are not positive leave the image as it is
+	for _, size := range [][2]int{{0, 2}, {2, -1}} {
+		image.Resize(size[0], size[1])
+		image.ResizeNN(size[0], size[1])
+		if image.Width != 4 || image.Height != 6 {
+			t.Errorf("resizing to %dx%d changed the image to %dx%d, want it left at 4x6", size[0], size[1], image.Width, image.Height)
+		}
+	}
+}
+
+func TestImageDraw(t *testing.T) {
+	dst := loadTestImage(t, 4, 4, func(x, y int) Color { return White })
+	src := loadTestImage(t, 2, 2, cornerColors)
+
+	dst.Draw(src, NewRectangle(0, 0, 2, 2), NewRectangle(1, 2, 2, 2), White)
+	pixels := dst.GetPixels()
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			want := White
+			if x >= 1 && x < 3 && y >= 2 {
+				want = cornerColors(x-1, y-2)
+			}
+			if pixels[x+y*4] != want {
+				t.Errorf("pixel %d, %d after Draw() = %v, want %v", x, y, pixels[x+y*4], want)
+			}
+		}
+	}
+
+	//Empty rectangles draw nothing
+	blank := loadTestImage(t, 4, 4, func(x, y int) Color { return White })
+	blank.Draw(src, NewRectangle(0, 0, 0, 2), NewRectangle(0, 0, 2, 2), White)
+	blank.Draw(src, NewRectangle(0, 0, 2, 2), NewRectangle(0, 0, 2, -1), White)
+	for i, p := range blank.GetPixels() {
+		if p != White {
+			t.Errorf("pixel %d after Draw() with an empty rectangle = %v, want white", i, p)
+		}
+	}
+}
@@ -56,3 +56,93 @@ const (
 	GamepadAxisLeftTrigger
 	GamepadAxisRightTrigger
 )
+
+//GamepadState is a snapshot of every button and axis of a gamepad
+type GamepadState struct {
+	//Connected is false if there is no gamepad, in which case nothing is down and every axis is 0
+	Connected bool
+	//Name is the name of the gamepad reported by the driver
+	Name string
+	//Buttons holds if each button is down
+	Buttons map[GamepadButton]bool
+	//Axes holds the movement of each axis, between -1 and 1
+	Axes map[GamepadAxis]float32
+}
+
+//gamepadButtons are all the buttons read by GetGamepadState
+var gamepadButtons = []GamepadButton{
+	GamepadButtonLeftFaceUp, GamepadButtonLeftFaceRight, GamepadButtonLeftFaceDown, GamepadButtonLeftFaceLeft,
+	GamepadButtonRightFaceUp, GamepadButtonRightFaceRight, GamepadButtonRightFaceDown, GamepadButtonRightFaceLeft,
+	GamepadButtonLeftTrigger1, GamepadButtonLeftTrigger2, GamepadButtonRightTrigger1, GamepadButtonRightTrigger2,
+	GamepadButtonMiddleLeft, GamepadButtonMiddle, GamepadButtonMiddleRight,
+	GamepadButtonLeftThumb, GamepadButtonRightThumb,
+}
+
+//gamepadAxes are all the axes read by GetGamepadState
+var gamepadAxes = []GamepadAxis{
+	GamepadAxisLeftX, GamepadAxisLeftY, GamepadAxisRightX, GamepadAxisRightY, GamepadAxisLeftTrigger, GamepadAxisRightTrigger,
+}
+
+//GetGamepadState reads every button and axis of the gamepad at once.
+// The maps always contain every known button and axis, even if the gamepad is not connected.
+func GetGamepadState(gamepad GamepadNumber) GamepadState {
+	state := GamepadState{
+		Connected: IsGamepadAvailable(gamepad),
+		Buttons:   make(map[GamepadButton]bool, len(gamepadButtons)),
+		Axes:      make(map[GamepadAxis]float32, len(gamepadAxes)),
+	}
+
+	if !state.Connected {
+		for _, button := range gamepadButtons {
+			state.Buttons[button] = false
+		}
+		for _, axis := range gamepadAxes {
+			state.Axes[axis] = 0
+		}
+		return state
+	}
+
+	state.Name = GetGamepadName(gamepad)
+	for _, button := range gamepadButtons {
+		state.Buttons[button] = IsGamepadButtonDown(gamepad, button)
+	}
+	for _, axis := range gamepadAxes {
+		state.Axes[axis] = GetGamepadAxisMovement(gamepad, axis)
+	}
+	return state
+}
+
+//IsButtonDown checks if the button was down when the state was read
+func (state GamepadState) IsButtonDown(button GamepadButton) bool { return state.Buttons[button] }
+
+//Axis gets the movement of the axis when the state was read
+func (state GamepadState) Axis(axis GamepadAxis) float32 { return state.Axes[axis] }
+
+//GetTouchPoints gets the position of every active touch point. Returns an empty slice if nothing is touching the screen.
+// The number of points comes from the gestures module, the same as GetTouchPointsCount.
+func GetTouchPoints() []Vector2 {
+	return touchPoints(GetTouchPointsCount(), GetTouchPosition)
+}
+
+//touchPoints gets the position of each of the touch points with the given function
+func touchPoints(count int, position func(index int) Vector2) []Vector2 {
+	points := make([]Vector2, 0, count)
+	for i := 0; i < count; i++ {
+		points = append(points, position(i))
+	}
+	return points
+}
+
+//GetTouchPointId gets the id of an active touch point, or -1 if there is no touch point at the index.
+// raylib 2.6 keeps each touch in its own slot instead of tracking the platform ids, so the id is the slot index.
+func GetTouchPointId(index int) int {
+	return touchPointId(index, GetTouchPointsCount())
+}
+
+//touchPointId gets the id of the touch point at the index when count points are active, or -1 if it is out of range
+func touchPointId(index, count int) int {
+	if index < 0 || index >= count {
+		return -1
+	}
+	return index
+}
@@ -0,0 +1,56 @@
+package raylib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTouchPoints(t *testing.T) {
+	tests := [][]Vector2{
+		{},
+		{NewVector2(10, 20)},
+		{NewVector2(10, 20), NewVector2(30, 40), NewVector2(50, 60)},
+	}
+
+	for _, points := range tests {
+		actual := touchPoints(len(points), func(index int) Vector2 { return points[index] })
+		if actual == nil || len(actual) != len(points) {
+			t.Errorf("touchPoints() = %v, want %d points", actual, len(points))
+			continue
+		}
+		if !reflect.DeepEqual(actual, points) {
+			t.Errorf("touchPoints() = %v, want %v", actual, points)
+		}
+	}
+}
+
+func TestTouchPointId(t *testing.T) {
+	for index, expected := range map[int]int{-1: -1, 0: 0, 1: 1, 2: -1} {
+		if id := touchPointId(index, 2); id != expected {
+			t.Errorf("touchPointId(%d, 2) = %d, want %d", index, id, expected)
+		}
+	}
+}
+
+func TestGetGamepadStateDisconnected(t *testing.T) {
+	//Without a window no gamepads are set up, so every gamepad is disconnected
+	state := GetGamepadState(GamepadPlayer4)
+
+	if state.Connected || state.Name != "" {
+		t.Errorf("GetGamepadState() = connected %v with name %q, want disconnected with no name", state.Connected, state.Name)
+	}
+	if len(state.Buttons) != len(gamepadButtons) || len(state.Axes) != len(gamepadAxes) {
+		t.Errorf("GetGamepadState() has %d buttons and %d axes, want %d and %d", len(state.Buttons), len(state.Axes), len(gamepadButtons), len(gamepadAxes))
+	}
+
+	for _, button := range gamepadButtons {
+		if down, ok := state.Buttons[button]; !ok || down || state.IsButtonDown(button) {
+			t.Errorf("button %d = %v (present %v), want present and up", button, down, ok)
+		}
+	}
+	for _, axis := range gamepadAxes {
+		if movement, ok := state.Axes[axis]; !ok || movement != 0 || state.Axis(axis) != 0 {
+			t.Errorf("axis %d = %v (present %v), want present and 0", axis, movement, ok)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import (
+	"os"
+	"testing"
+)
+
+/*
+The integration tests need a real OpenGL context and audio device, so they are only built with the integration tag.
+On a machine without a display, run them under a virtual framebuffer:
+	xvfb-run -a go test -tags integration ./raylib
+*/
+
+const integrationWidth = 64
+const integrationHeight = 64
+
+//mainThread runs functions on the main thread, which owns the OpenGL context
+var mainThread = make(chan func())
+
+func TestMain(m *testing.M) {
+	//The package init locked this goroutine to the main thread, so raylib is used from here and the tests run on another goroutine
+	InitHeadless(integrationWidth, integrationHeight)
+	InitAudioDevice()
+
+	done := make(chan int)
+	go func() { done <- m.Run() }()
+
+	for {
+		select {
+		case fn := <-mainThread:
+			fn()
+		case code := <-done:
+			if IsAudioDeviceReady() {
+				CloseAudioDevice()
+			}
+			if IsWindowReady() {
+				CloseWindow()
+			}
+			os.Exit(code)
+		}
+	}
+}
+
+//onMainThread runs the function on the main thread and waits for it to finish
+func onMainThread(fn func()) {
+	finished := make(chan struct{})
+	mainThread <- func() {
+		defer close(finished)
+		fn()
+	}
+	<-finished
+}
+
+//requireWindow skips the test if the hidden window could not be created, such as when there is no display
+func requireWindow(t testing.TB) {
+	t.Helper()
+	ready := false
+	onMainThread(func() { ready = IsWindowReady() })
+	if !ready {
+		t.Skip("no OpenGL context, run under a display or xvfb-run")
+	}
+}
+
+//requireAudio skips the test if there is no audio device
+func requireAudio(t testing.TB) {
+	t.Helper()
+	ready := false
+	onMainThread(func() { ready = IsAudioDeviceReady() })
+	if !ready {
+		t.Skip("no audio device")
+	}
+}
@@ -156,3 +156,29 @@ func GetKeyPressed() Key {
 func SetExitKey(key Key) {
 	C.SetExitKey(C.int(key))
 }
+
+//IsKeyChordPressed checks if the key was pressed this frame while all of the modifiers are held down, such as Ctrl+Shift+S.
+// With no modifiers this is the same as IsKeyPressed.
+func IsKeyChordPressed(modifiers []Key, key Key) bool {
+	return isKeyChordPressed(modifiers, key, IsKeyDown, IsKeyPressed)
+}
+
+//isKeyChordPressed checks a key chord using the functions to read the keys. Repeated modifiers, or the key itself, are only checked once.
+func isKeyChordPressed(modifiers []Key, key Key, isDown, isPressed func(Key) bool) bool {
+	if !isPressed(key) {
+		return false
+	}
+
+	checked := map[Key]bool{key: true}
+	for _, modifier := range modifiers {
+		if checked[modifier] {
+			continue
+		}
+		checked[modifier] = true
+
+		if !isDown(modifier) {
+			return false
+		}
+	}
+	return true
+}
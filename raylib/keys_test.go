@@ -0,0 +1,45 @@
+package raylib
+
+import "testing"
+
+func TestIsKeyChordPressed(t *testing.T) {
+	tests := []struct {
+		name      string
+		down      []Key
+		pressed   Key
+		modifiers []Key
+		key       Key
+		expected  bool
+	}{
+		{"chord held", []Key{KeyLeftControl, KeyLeftShift}, KeyS, []Key{KeyLeftControl, KeyLeftShift}, KeyS, true},
+		{"missing modifier", []Key{KeyLeftControl}, KeyS, []Key{KeyLeftControl, KeyLeftShift}, KeyS, false},
+		{"key not pressed", []Key{KeyLeftControl, KeyLeftShift}, KeyA, []Key{KeyLeftControl, KeyLeftShift}, KeyS, false},
+		{"extra keys held", []Key{KeyLeftControl, KeyLeftAlt}, KeyS, []Key{KeyLeftControl}, KeyS, true},
+		{"no modifiers", nil, KeyS, nil, KeyS, true},
+		{"repeated modifier", []Key{KeyLeftControl}, KeyS, []Key{KeyLeftControl, KeyLeftControl}, KeyS, true},
+		{"key as a modifier", nil, KeyS, []Key{KeyS}, KeyS, true},
+	}
+
+	for _, test := range tests {
+		checks := make(map[Key]int)
+		isDown := func(key Key) bool {
+			checks[key]++
+			for _, down := range test.down {
+				if down == key {
+					return true
+				}
+			}
+			return false
+		}
+		isPressed := func(key Key) bool { return key == test.pressed }
+
+		if actual := isKeyChordPressed(test.modifiers, test.key, isDown, isPressed); actual != test.expected {
+			t.Errorf("%s: isKeyChordPressed() = %v, want %v", test.name, actual, test.expected)
+		}
+		for key, count := range checks {
+			if count > 1 {
+				t.Errorf("%s: key %d was checked %d times, want once", test.name, key, count)
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package raylib
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//logRing is a fixed size buffer that overwrites the oldest lines once full
+type logRing struct {
+	mutex sync.Mutex
+	lines []string
+	start int
+	count int
+}
+
+func newLogRing(size int) *logRing {
+	if size < 1 {
+		size = 1
+	}
+	return &logRing{lines: make([]string, size)}
+}
+
+//add appends the line, dropping the oldest line if the buffer is full
+func (ring *logRing) add(line string) {
+	ring.mutex.Lock()
+	defer ring.mutex.Unlock()
+
+	if ring.count < len(ring.lines) {
+		ring.lines[(ring.start+ring.count)%len(ring.lines)] = line
+		ring.count++
+		return
+	}
+
+	ring.lines[ring.start] = line
+	ring.start = (ring.start + 1) % len(ring.lines)
+}
+
+//snapshot copies the lines from oldest to newest
+func (ring *logRing) snapshot() []string {
+	ring.mutex.Lock()
+	defer ring.mutex.Unlock()
+
+	lines := make([]string, ring.count)
+	for i := range lines {
+		lines[i] = ring.lines[(ring.start+i)%len(ring.lines)]
+	}
+	return lines
+}
+
+//logBufferMutex guards logBuffer and logBufferPrevious, as messages can be logged from any goroutine
+var logBufferMutex sync.Mutex
+var logBuffer *logRing
+var logBufferPrevious func(logType TraceLogType, text string)
+
+//EnableLogBuffer starts keeping the most recent size log messages in memory, so they can be shown in an in-game console.
+// This hooks the trace log callback. Any callback that was already set will still be called, otherwise the messages are
+// still printed to the standard output. Calling SetTraceLogCallback afterwards will stop the buffer receiving messages.
+func EnableLogBuffer(size int) {
+	logBufferMutex.Lock()
+	defer logBufferMutex.Unlock()
+
+	if logBuffer == nil {
+		logBufferPrevious = traceCallback
+	}
+
+	ring := newLogRing(size)
+	logBuffer = ring
+	previous := logBufferPrevious
+
+	SetTraceLogCallback(func(logType TraceLogType, text string) {
+		line := strings.ToUpper(logType.ToString()) + ": " + text
+
+		//Only capture while this is still the active buffer
+		logBufferMutex.Lock()
+		active := logBuffer == ring
+		logBufferMutex.Unlock()
+		if active {
+			ring.add(line)
+		}
+
+		if previous != nil {
+			previous(logType, text)
+		} else {
+			fmt.Println(line)
+		}
+	})
+}
+
+//DisableLogBuffer stops capturing log messages and restores the callback that was set before EnableLogBuffer
+func DisableLogBuffer() {
+	logBufferMutex.Lock()
+	defer logBufferMutex.Unlock()
+
+	if logBuffer == nil {
+		return
+	}
+
+	logBuffer = nil
+	SetTraceLogCallback(logBufferPrevious)
+	logBufferPrevious = nil
+}
+
+//LogBuffer gets the captured log messages from oldest to newest. This is empty if the buffer is not enabled.
+func LogBuffer() []string {
+	logBufferMutex.Lock()
+	ring := logBuffer
+	logBufferMutex.Unlock()
+
+	if ring == nil {
+		return []string{}
+	}
+	return ring.snapshot()
+}
@@ -0,0 +1,89 @@
+package raylib
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestLogRingDropsOldest(t *testing.T) {
+	ring := newLogRing(3)
+
+	expected := [][]string{
+		{"1"},
+		{"1", "2"},
+		{"1", "2", "3"},
+		{"2", "3", "4"},
+		{"3", "4", "5"},
+	}
+	for i, lines := range expected {
+		ring.add(fmt.Sprint(i + 1))
+		if actual := ring.snapshot(); !reflect.DeepEqual(actual, lines) {
+			t.Errorf("snapshot() after adding %d lines = %v, want %v", i+1, actual, lines)
+		}
+	}
+
+	small := newLogRing(0)
+	small.add("a")
+	small.add("b")
+	if actual := small.snapshot(); !reflect.DeepEqual(actual, []string{"b"}) {
+		t.Errorf("snapshot() of a ring with no size = %v, want only the newest line", actual)
+	}
+}
+
+func TestLogBuffer(t *testing.T) {
+	forwarded := make([]string, 0)
+	SetTraceLogCallback(func(logType TraceLogType, text string) { forwarded = append(forwarded, text) })
+	defer SetTraceLogCallback(nil)
+
+	EnableLogBuffer(2)
+	TraceLog(LogWarning, "first")
+	TraceLog(LogWarning, "second")
+	TraceLog(LogWarning, "third")
+
+	if expected := []string{"WARNING: second", "WARNING: third"}; !reflect.DeepEqual(LogBuffer(), expected) {
+		t.Errorf("LogBuffer() = %v, want %v", LogBuffer(), expected)
+	}
+	if expected := []string{"first", "second", "third"}; !reflect.DeepEqual(forwarded, expected) {
+		t.Errorf("previous callback received %v, want %v", forwarded, expected)
+	}
+
+	DisableLogBuffer()
+	TraceLog(LogWarning, "fourth")
+	if lines := LogBuffer(); len(lines) != 0 {
+		t.Errorf("LogBuffer() after DisableLogBuffer() = %v, want empty", lines)
+	}
+	if len(forwarded) != 4 {
+		t.Errorf("previous callback was not restored, it received %v", forwarded)
+	}
+}
+
+func TestLogBufferConcurrent(t *testing.T) {
+	SetTraceLogCallback(func(logType TraceLogType, text string) {})
+	defer SetTraceLogCallback(nil)
+	EnableLogBuffer(16)
+	defer DisableLogBuffer()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				TraceLog(LogWarning, i, j)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				LogBuffer()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if lines := LogBuffer(); len(lines) != 16 {
+		t.Errorf("LogBuffer() = %d lines, want 16", len(lines))
+	}
+}
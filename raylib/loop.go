@@ -0,0 +1,63 @@
+package raylib
+
+//Run runs the game loop until the window is closed, calling update with the frame time then draw between BeginDrawing and EndDrawing.
+func Run(update func(dt float32), draw func()) {
+	for !WindowShouldClose() {
+		update(GetFrameTime())
+
+		BeginDrawing()
+		draw()
+		EndDrawing()
+	}
+}
+
+//RunFixed runs the game loop until the window is closed, calling update with a fixed dt so the simulation is reproducible.
+// Update is called as many times as needed to catch up with the real time, but no more than maxSteps per frame so a slow
+// frame cannot cause a spiral of ever more updates. Any time that could not be caught up is dropped.
+func RunFixed(update func(dt float32), draw func(), dt float32, maxSteps int) {
+	stepper := newFixedStepper(dt, maxSteps)
+	for !WindowShouldClose() {
+		for steps := stepper.advance(GetFrameTime()); steps > 0; steps-- {
+			update(dt)
+		}
+
+		BeginDrawing()
+		draw()
+		EndDrawing()
+	}
+}
+
+//fixedStepper accumulates elapsed time and works out how many fixed steps should be taken
+type fixedStepper struct {
+	dt          float32
+	maxSteps    int
+	accumulator float32
+}
+
+func newFixedStepper(dt float32, maxSteps int) *fixedStepper {
+	if maxSteps < 1 {
+		maxSteps = 1
+	}
+	return &fixedStepper{dt: dt, maxSteps: maxSteps}
+}
+
+//advance adds the elapsed time and returns how many steps to take
+func (stepper *fixedStepper) advance(elapsed float32) int {
+	if stepper.dt <= 0 {
+		return 1
+	}
+
+	stepper.accumulator += elapsed
+	steps := 0
+	for stepper.accumulator >= stepper.dt && steps < stepper.maxSteps {
+		stepper.accumulator -= stepper.dt
+		steps++
+	}
+
+	//We have fallen too far behind, so drop the time we could not catch up
+	if steps == stepper.maxSteps && stepper.accumulator >= stepper.dt {
+		stepper.accumulator = 0
+	}
+
+	return steps
+}
@@ -0,0 +1,29 @@
+package raylib
+
+import "testing"
+
+func TestFixedStepperCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		dt       float32
+		maxSteps int
+		frames   []float32
+		steps    []int
+	}{
+		{"frames shorter than dt", 0.25, 5, []float32{0.125, 0.125, 0.125, 0.125, 0.125}, []int{0, 1, 0, 1, 0}},
+		{"frames longer than dt", 0.25, 5, []float32{0.375, 0.375, 0.375, 0.375}, []int{1, 2, 1, 2}},
+		{"exact frames", 0.25, 5, []float32{0.25, 0.5, 0.75}, []int{1, 2, 3}},
+		{"too far behind", 0.25, 3, []float32{2, 0.125, 0.25}, []int{3, 0, 1}},
+		{"no dt", 0, 3, []float32{1, 0}, []int{1, 1}},
+		{"no max steps", 0.25, 0, []float32{1}, []int{1}},
+	}
+
+	for _, test := range tests {
+		stepper := newFixedStepper(test.dt, test.maxSteps)
+		for i, elapsed := range test.frames {
+			if steps := stepper.advance(elapsed); steps != test.steps[i] {
+				t.Errorf("%s: frame %d advance(%v) = %d, want %d", test.name, i, elapsed, steps, test.steps[i])
+			}
+		}
+	}
+}
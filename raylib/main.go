@@ -62,6 +62,10 @@ const (
 	FlagMsaa4xHint = 32
 	// Set to try enabling V-Sync on GPU
 	FlagVsyncHint = 64
+	// Set to create the window initially hidden
+	FlagWindowHidden = 128
+	// Set to allow windows running while minimized
+	FlagWindowAlwaysRun = 256
 )
 
 var screenWidth = 0
@@ -240,7 +240,9 @@ func EndMode3D() {
 }
 
 //BeginTextureMode : Initializes render texture for drawing
+// The pixels of the texture cached by ToImage are cleared, as it is being drawn to.
 func BeginTextureMode(target RenderTexture2D) {
+	invalidateTextureImage(target.Texture)
 	ctarget := *target.cptr()
 	C.BeginTextureMode(ctarget)
 }
@@ -380,6 +382,9 @@ func SetConfigFlags(flags uint32) {
 //SetTraceLogExit is in trace.go
 
 //SetTraceLogCallback is in trace.go
+
+//TraceLog is in trace.go
+
 //TakeScreenshot : Takes a screenshot of current screen (saved a .png)
 func TakeScreenshot(fileName string) {
 	cfileName := C.CString(fileName)
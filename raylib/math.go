@@ -50,3 +50,29 @@ func Inf(sign int) float32 {
 
 	return Float32frombits(0xFF800000)
 }
+
+//SmoothDamp gradually moves the current value towards the target like a critically damped spring, never overshooting.
+// The velocity is an accumulator that is modified by every call, so keep it between calls. The smoothTime is roughly
+// how long in seconds it takes to reach the target. Based on Game Programming Gems 4, Chapter 1.10.
+func SmoothDamp(current, target float32, velocity *float32, smoothTime, dt float32) float32 {
+	if smoothTime < 0.0001 {
+		smoothTime = 0.0001
+	}
+
+	omega := 2 / smoothTime
+	x := omega * dt
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := current - target
+	temp := (*velocity + omega*change) * dt
+	*velocity = (*velocity - omega*temp) * exp
+	output := target + (change+temp)*exp
+
+	//Prevent overshooting
+	if (target-current > 0) == (output > target) {
+		output = target
+		*velocity = 0
+	}
+
+	return output
+}
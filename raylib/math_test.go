@@ -0,0 +1,63 @@
+package raylib
+
+import "testing"
+
+func TestSmoothDamp(t *testing.T) {
+	tests := []struct {
+		name                    string
+		start, target, velocity float32
+		dt                      float32
+	}{
+		{"upwards", 0, 10, 0, 1.0 / 60},
+		{"downwards", 10, -5, 0, 1.0 / 60},
+		{"large steps", 0, 10, 0, 1},
+		{"fast towards the target", 0, 10, 500, 1.0 / 60},
+		{"moving away", 0, 10, -50, 1.0 / 60},
+	}
+
+	for _, test := range tests {
+		current, velocity := test.start, test.velocity
+		sign := float32(1)
+		if test.target < test.start {
+			sign = -1
+		}
+
+		for i := 0; i < 300; i++ {
+			current = SmoothDamp(current, test.target, &velocity, 0.3, test.dt)
+			if (current-test.target)*sign > 0 {
+				t.Errorf("%s: step %d overshot to %v, past the target %v", test.name, i, current, test.target)
+				break
+			}
+		}
+
+		if diff := current - test.target; diff < -0.01 || diff > 0.01 {
+			t.Errorf("%s: SmoothDamp() = %v after 300 steps, want it to reach %v", test.name, current, test.target)
+		}
+	}
+}
+
+func TestVector2SmoothDamp(t *testing.T) {
+	target := NewVector2(10, -20)
+	current := NewVector2(-5, 4)
+	velocity := NewVector2(0, 0)
+
+	previous := current.Distance(target)
+	for i := 0; i < 300; i++ {
+		next := current.SmoothDamp(target, &velocity, 0.3, 1.0/60)
+
+		//It should never pass the target along the direction it is moving
+		if target.Subtract(current).DotProduct(next.Subtract(target)) > 0 {
+			t.Fatalf("step %d overshot from %v to %v, past the target %v", i, current, next, target)
+		}
+		if distance := next.Distance(target); distance > previous+testEpsilon {
+			t.Fatalf("step %d moved away from the target, from %v to %v", i, previous, distance)
+		}
+
+		current = next
+		previous = current.Distance(target)
+	}
+
+	if previous > 0.01 {
+		t.Errorf("SmoothDamp() = %v after 300 steps, want it to reach %v", current, target)
+	}
+}
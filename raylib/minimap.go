@@ -0,0 +1,165 @@
+package raylib
+
+//MinimapCorner is the corner of the screen a minimap is anchored to
+type MinimapCorner int
+
+const (
+	MinimapTopLeft MinimapCorner = iota
+	MinimapTopRight
+	MinimapBottomLeft
+	MinimapBottomRight
+)
+
+//Minimap renders a scaled down top-down view of the world into a render texture and draws it on the screen.
+// Draw the world between Begin and End, then call Draw while drawing the screen.
+type Minimap struct {
+	//World is the area of the world that the minimap shows
+	World Rectangle
+	//Bounds is the area on the screen that the minimap is drawn to
+	Bounds Rectangle
+	//Target is the render texture the minimap is drawn into
+	Target RenderTexture2D
+	//Background is the colour the minimap is cleared with
+	Background Color
+	//Border is the colour of the outline around the minimap
+	Border Color
+	//ViewportColor is the colour of the outline showing the area of the world that is visible
+	ViewportColor Color
+}
+
+//LoadMinimap creates a new minimap showing the area of the world, with a render texture of the given size.
+// The minimap is initially drawn at the top left of the screen at its full size.
+func LoadMinimap(world Rectangle, width, height int) *Minimap {
+	minimap := &Minimap{
+		World:         world,
+		Bounds:        NewRectangle(0, 0, float32(width), float32(height)),
+		Target:        LoadRenderTexture(width, height),
+		Background:    Black,
+		Border:        White,
+		ViewportColor: White,
+	}
+
+	//The minimap unloads its render texture, so it must not be unloaded again by UnloadAll
+	UnregisterUnloadable(minimap.Target)
+	RegisterUnloadable(minimap)
+	return minimap
+}
+
+//Unload unloads the render texture of the minimap
+func (minimap *Minimap) Unload() {
+	minimap.Target.Unload()
+	UnregisterUnloadable(minimap)
+}
+
+//Anchor moves the minimap into a corner of the screen, with a margin between it and the edges.
+func (minimap *Minimap) Anchor(corner MinimapCorner, screenWidth, screenHeight int, margin float32) {
+	x, y := margin, margin
+	if corner == MinimapTopRight || corner == MinimapBottomRight {
+		x = float32(screenWidth) - minimap.Bounds.Width - margin
+	}
+	if corner == MinimapBottomLeft || corner == MinimapBottomRight {
+		y = float32(screenHeight) - minimap.Bounds.Height - margin
+	}
+	minimap.Bounds.X, minimap.Bounds.Y = x, y
+}
+
+//Camera gets the camera that draws the world into the minimap's render texture.
+// The world is scaled evenly so it fits entirely within the texture.
+func (minimap *Minimap) Camera() Camera2D {
+	return Camera2D{
+		Offset: NewVector2Zero(),
+		Target: minimap.World.Position(),
+		Zoom:   minimap.scale(),
+	}
+}
+
+//scale is how many texture pixels a single world unit takes up
+func (minimap *Minimap) scale() float32 {
+	if minimap.World.Width <= 0 || minimap.World.Height <= 0 {
+		return 1
+	}
+
+	scaleX := float32(minimap.Target.Texture.Width) / minimap.World.Width
+	scaleY := float32(minimap.Target.Texture.Height) / minimap.World.Height
+	if scaleY < scaleX {
+		return scaleY
+	}
+	return scaleX
+}
+
+//textureToScreen is how many screen pixels a single texture pixel takes up on each axis
+func (minimap *Minimap) textureToScreen() Vector2 {
+	if minimap.Target.Texture.Width == 0 || minimap.Target.Texture.Height == 0 {
+		return NewVector2(1, 1)
+	}
+	return NewVector2(
+		minimap.Bounds.Width/float32(minimap.Target.Texture.Width),
+		minimap.Bounds.Height/float32(minimap.Target.Texture.Height),
+	)
+}
+
+//WorldToMinimap converts a position in the world into the position on the screen where it appears on the minimap
+func (minimap *Minimap) WorldToMinimap(world Vector2) Vector2 {
+	texture := world.Subtract(minimap.World.Position()).Scale(minimap.scale())
+	ratio := minimap.textureToScreen()
+	return NewVector2(texture.X*ratio.X, texture.Y*ratio.Y).Add(minimap.Bounds.Position())
+}
+
+//MinimapToWorld converts a position on the screen over the minimap into the position in the world.
+// This is the reverse of WorldToMinimap.
+func (minimap *Minimap) MinimapToWorld(screen Vector2) Vector2 {
+	ratio := minimap.textureToScreen()
+	local := screen.Subtract(minimap.Bounds.Position())
+	texture := NewVector2(local.X/ratio.X, local.Y/ratio.Y)
+	return texture.Divide(minimap.scale()).Add(minimap.World.Position())
+}
+
+//Contains checks if the screen position is over the minimap
+func (minimap *Minimap) Contains(screen Vector2) bool {
+	return CheckCollisionPointRec(screen, minimap.Bounds)
+}
+
+//GetClickedPosition gets the world position that was clicked on the minimap this frame, useful for click-to-teleport.
+// The second result is false if the minimap was not clicked.
+func (minimap *Minimap) GetClickedPosition(button MouseButton) (Vector2, bool) {
+	if !IsMouseButtonPressed(button) {
+		return Vector2{}, false
+	}
+
+	mouse := GetMousePosition()
+	if !minimap.Contains(mouse) {
+		return Vector2{}, false
+	}
+
+	return minimap.MinimapToWorld(mouse), true
+}
+
+//Begin starts drawing the world into the minimap. This should be called outside of BeginDrawing.
+func (minimap *Minimap) Begin() {
+	BeginTextureMode(minimap.Target)
+	ClearBackground(minimap.Background)
+	BeginMode2D(minimap.Camera())
+}
+
+//End stops drawing to the minimap
+func (minimap *Minimap) End() {
+	EndMode2D()
+	EndTextureMode()
+}
+
+//Draw draws the minimap to the screen with an outline of the viewport, which is the area of the world currently visible.
+// Call this between BeginDrawing and EndDrawing.
+func (minimap *Minimap) Draw(viewport Rectangle) {
+	//Render textures are upside down, so flip the source
+	source := NewRectangle(0, 0, float32(minimap.Target.Texture.Width), -float32(minimap.Target.Texture.Height))
+	DrawTexturePro(minimap.Target.Texture, source, minimap.Bounds, NewVector2Zero(), 0, White)
+
+	//Draw the viewport, but keep it within the minimap
+	min := minimap.WorldToMinimap(viewport.MinPosition()).Max(minimap.Bounds.MinPosition())
+	max := minimap.WorldToMinimap(viewport.MaxPosition()).Min(minimap.Bounds.MaxPosition())
+	if max.X > min.X && max.Y > min.Y {
+		DrawRectangleLinesEx(NewRectangleFromPositionSize(min, max.Subtract(min)), 1, minimap.ViewportColor)
+	}
+
+	DrawRectangleLinesEx(minimap.Bounds, 1, minimap.Border)
+}
@@ -0,0 +1,53 @@
+package raylib
+
+import "testing"
+
+//testMinimap shows a 1000x500 world on a 200x200 texture, drawn at half size on the screen
+func testMinimap() *Minimap {
+	return &Minimap{
+		World:  NewRectangle(100, 200, 1000, 500),
+		Bounds: NewRectangle(10, 20, 100, 100),
+		Target: RenderTexture2D{Texture: Texture2D{Width: 200, Height: 200}},
+	}
+}
+
+func TestMinimapWorldToMinimap(t *testing.T) {
+	minimap := testMinimap()
+
+	//The world is scaled by 0.2 to fit its width, then halved onto the screen
+	tests := []struct {
+		world, screen Vector2
+	}{
+		{NewVector2(100, 200), NewVector2(10, 20)},
+		{NewVector2(600, 450), NewVector2(60, 45)},
+		{NewVector2(1100, 700), NewVector2(110, 70)},
+		{NewVector2(0, 0), NewVector2(0, 0)},
+	}
+
+	for _, test := range tests {
+		screen := minimap.WorldToMinimap(test.world)
+		if !vector2NearlyEqual(screen, test.screen) {
+			t.Errorf("WorldToMinimap(%v) = %v, want %v", test.world, screen, test.screen)
+		}
+		if world := minimap.MinimapToWorld(screen); !vector2NearlyEqual(world, test.world) {
+			t.Errorf("MinimapToWorld(%v) = %v, want %v", screen, world, test.world)
+		}
+	}
+}
+
+func TestMinimapAnchor(t *testing.T) {
+	minimap := testMinimap()
+
+	minimap.Anchor(MinimapBottomRight, 800, 600, 8)
+	if position := minimap.Bounds.Position(); !vector2NearlyEqual(position, NewVector2(692, 492)) {
+		t.Errorf("bottom right anchor = %v, want %v", position, NewVector2(692, 492))
+	}
+	if !minimap.Contains(NewVector2(700, 500)) || minimap.Contains(NewVector2(10, 20)) {
+		t.Errorf("Contains() does not follow the anchored bounds %v", minimap.Bounds)
+	}
+
+	minimap.Anchor(MinimapTopLeft, 800, 600, 8)
+	if position := minimap.Bounds.Position(); !vector2NearlyEqual(position, NewVector2(8, 8)) {
+		t.Errorf("top left anchor = %v, want %v", position, NewVector2(8, 8))
+	}
+}
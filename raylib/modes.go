@@ -0,0 +1,37 @@
+package raylib
+
+/*
+These wrap the Begin and End pairs in a function, so the End can never be forgotten.
+The End is deferred, so it will still be called if the function panics.
+*/
+
+//Mode2D draws everything in the function with the 2D camera
+func Mode2D(camera Camera2D, fn func()) {
+	withMode(func() { BeginMode2D(camera) }, EndMode2D, fn)
+}
+
+//Mode3D draws everything in the function with the 3D camera
+func Mode3D(camera Camera, fn func()) {
+	withMode(func() { BeginMode3D(camera) }, EndMode3D, fn)
+}
+
+//TextureMode draws everything in the function into the render texture.
+// The pixels of the texture cached by ToImage are cleared again at the end, in case ToImage was called while drawing.
+func TextureMode(target RenderTexture2D, fn func()) {
+	textureMode(target, BeginTextureMode, EndTextureMode, fn)
+}
+
+//textureMode draws the function into the render texture with the given begin and end, clearing its cached pixels once it has ended
+func textureMode(target RenderTexture2D, begin func(RenderTexture2D), end func(), fn func()) {
+	withMode(func() { begin(target) }, func() {
+		defer invalidateTextureImage(target.Texture)
+		end()
+	}, fn)
+}
+
+//withMode calls begin, then the function, then end. The end is deferred so it is still called if the function panics.
+func withMode(begin, end func(), fn func()) {
+	begin()
+	defer end()
+	fn()
+}
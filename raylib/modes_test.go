@@ -0,0 +1,45 @@
+package raylib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModesArePaired(t *testing.T) {
+	calls := []string{}
+	record := func(call string) func() { return func() { calls = append(calls, call) } }
+
+	withMode(record("begin outer"), record("end outer"), func() {
+		record("draw outer")()
+		withMode(record("begin first"), record("end first"), record("draw first"))
+		withMode(record("begin second"), record("end second"), record("draw second"))
+	})
+
+	expected := []string{
+		"begin outer", "draw outer",
+		"begin first", "draw first", "end first",
+		"begin second", "draw second", "end second",
+		"end outer",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("calls = %v, want %v", calls, expected)
+	}
+}
+
+func TestModesEndWhenPanicking(t *testing.T) {
+	calls := []string{}
+	record := func(call string) func() { return func() { calls = append(calls, call) } }
+
+	func() {
+		defer func() {
+			if r := recover(); r != "draw failed" {
+				t.Errorf("recovered %v, want the panic to carry on", r)
+			}
+		}()
+		withMode(record("begin"), record("end"), func() { panic("draw failed") })
+	}()
+
+	if expected := []string{"begin", "end"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("calls after panicking = %v, want %v", calls, expected)
+	}
+}
@@ -0,0 +1,83 @@
+package raylib
+
+//MouseDrag tracks the mouse while a button is held down, such as for dragging objects or selection boxes.
+// Call Update once per frame.
+type MouseDrag struct {
+	//Button is the mouse button that drags
+	Button MouseButton
+	//Threshold is how far in pixels the mouse has to move while held before it counts as dragging, so clicks are not drags
+	Threshold float32
+
+	start    Vector2
+	previous Vector2
+	delta    Vector2
+	total    Vector2
+	held     bool
+	dragging bool
+
+	isDown      func(button MouseButton) bool
+	getPosition func() Vector2
+}
+
+//NewMouseDrag creates a tracker for the button that starts dragging as soon as the mouse moves
+func NewMouseDrag(button MouseButton) *MouseDrag {
+	return &MouseDrag{
+		Button:      button,
+		isDown:      IsMouseButtonDown,
+		getPosition: GetMousePosition,
+	}
+}
+
+//Update reads the mouse. The drag starts where the button was pressed and is reset once the button is released.
+func (drag *MouseDrag) Update() {
+	down := drag.isDown(drag.Button)
+	position := drag.getPosition()
+
+	if !down {
+		drag.held = false
+		drag.dragging = false
+		drag.delta = Vector2{}
+		drag.total = Vector2{}
+		return
+	}
+
+	//Just pressed, so start a new drag
+	if !drag.held {
+		drag.held = true
+		drag.start = position
+		drag.previous = position
+	}
+
+	drag.total = position.Subtract(drag.start)
+	if !drag.dragging && drag.total.Length() > drag.Threshold {
+		drag.dragging = true
+	}
+
+	drag.delta = Vector2{}
+	if drag.dragging {
+		drag.delta = position.Subtract(drag.previous)
+	}
+	drag.previous = position
+}
+
+//IsDragging checks if the button is held and the mouse has moved past the threshold
+func (drag *MouseDrag) IsDragging() bool { return drag.dragging }
+
+//IsHeld checks if the button is held, even if the mouse has not moved past the threshold
+func (drag *MouseDrag) IsHeld() bool { return drag.held }
+
+//Start is where the button was pressed
+func (drag *MouseDrag) Start() Vector2 { return drag.start }
+
+//Delta is how far the mouse moved this frame while dragging
+func (drag *MouseDrag) Delta() Vector2 { return drag.delta }
+
+//TotalDelta is how far the mouse has moved from the start while the button is held
+func (drag *MouseDrag) TotalDelta() Vector2 { return drag.total }
+
+//Rectangle is the area between the start and the mouse, such as for a selection box
+func (drag *MouseDrag) Rectangle() Rectangle {
+	end := drag.start.Add(drag.total)
+	min, max := drag.start.Min(end), drag.start.Max(end)
+	return NewRectangleFromPositionSize(min, max.Subtract(min))
+}
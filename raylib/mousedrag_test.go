@@ -0,0 +1,55 @@
+package raylib
+
+import "testing"
+
+func TestMouseDrag(t *testing.T) {
+	var down bool
+	var position Vector2
+
+	drag := NewMouseDrag(MouseLeftButton)
+	drag.Threshold = 5
+	drag.isDown = func(button MouseButton) bool { return down && button == MouseLeftButton }
+	drag.getPosition = func() Vector2 { return position }
+
+	steps := []struct {
+		name     string
+		down     bool
+		position Vector2
+		held     bool
+		dragging bool
+		start    Vector2
+		delta    Vector2
+		total    Vector2
+	}{
+		{"press", true, NewVector2(10, 10), true, false, NewVector2(10, 10), Vector2{}, Vector2{}},
+		{"within threshold", true, NewVector2(12, 10), true, false, NewVector2(10, 10), Vector2{}, NewVector2(2, 0)},
+		{"past threshold", true, NewVector2(20, 14), true, true, NewVector2(10, 10), NewVector2(8, 4), NewVector2(10, 4)},
+		{"move", true, NewVector2(25, 14), true, true, NewVector2(10, 10), NewVector2(5, 0), NewVector2(15, 4)},
+		{"release", false, NewVector2(25, 14), false, false, NewVector2(10, 10), Vector2{}, Vector2{}},
+		{"moved while released", false, NewVector2(60, 60), false, false, NewVector2(10, 10), Vector2{}, Vector2{}},
+		{"press again", true, NewVector2(100, 100), true, false, NewVector2(100, 100), Vector2{}, Vector2{}},
+		{"drag backwards", true, NewVector2(90, 95), true, true, NewVector2(100, 100), NewVector2(-10, -5), NewVector2(-10, -5)},
+	}
+
+	for _, step := range steps {
+		down, position = step.down, step.position
+		drag.Update()
+
+		if drag.IsHeld() != step.held || drag.IsDragging() != step.dragging {
+			t.Errorf("%s: IsHeld() = %v, IsDragging() = %v, want %v and %v", step.name, drag.IsHeld(), drag.IsDragging(), step.held, step.dragging)
+		}
+		if drag.Start() != step.start {
+			t.Errorf("%s: Start() = %v, want %v", step.name, drag.Start(), step.start)
+		}
+		if drag.Delta() != step.delta {
+			t.Errorf("%s: Delta() = %v, want %v", step.name, drag.Delta(), step.delta)
+		}
+		if drag.TotalDelta() != step.total {
+			t.Errorf("%s: TotalDelta() = %v, want %v", step.name, drag.TotalDelta(), step.total)
+		}
+	}
+
+	if rect, expected := drag.Rectangle(), NewRectangle(90, 95, 10, 5); rect != expected {
+		t.Errorf("Rectangle() dragging backwards = %v, want %v", rect, expected)
+	}
+}
@@ -0,0 +1,207 @@
+package raylib
+
+import "math"
+
+//SetLooping sets if the music starts again once it finishes, or only plays once
+func (music *Music) SetLooping(loop bool) {
+	if loop {
+		music.SetLoopCount(0)
+	} else {
+		music.SetLoopCount(1)
+	}
+}
+
+//IsLooping checks if the music starts again once it finishes
+func (music *Music) IsLooping() bool { return music.LoopCount == 0 }
+
+//MusicManager keeps track of playing music streams and updates all of them in one call.
+// Every music stream needs UpdateMusicStream called each frame, and forgetting to do so causes the audio to stutter.
+type MusicManager struct {
+	tracks []*managedMusic
+
+	update    func(music *Music)
+	isPlaying func(music *Music) bool
+}
+
+type managedMusic struct {
+	music  *Music
+	paused bool
+}
+
+//NewMusicManager creates a new empty manager
+func NewMusicManager() *MusicManager {
+	return &MusicManager{
+		tracks:    make([]*managedMusic, 0),
+		update:    UpdateMusicStream,
+		isPlaying: IsMusicPlaying,
+	}
+}
+
+//Add registers the music to be updated. Adding the same music twice has no effect.
+func (mm *MusicManager) Add(music *Music) {
+	if mm.find(music) >= 0 {
+		return
+	}
+	mm.tracks = append(mm.tracks, &managedMusic{music: music})
+}
+
+//Remove stops updating the music. This does not stop or unload it.
+func (mm *MusicManager) Remove(music *Music) {
+	if i := mm.find(music); i >= 0 {
+		mm.tracks = append(mm.tracks[:i], mm.tracks[i+1:]...)
+	}
+}
+
+//Play registers the music and starts playing it
+func (mm *MusicManager) Play(music *Music) {
+	mm.Add(music)
+	mm.tracks[mm.find(music)].paused = false
+	music.PlayStream()
+}
+
+//Pause pauses the music. Paused music stays registered.
+func (mm *MusicManager) Pause(music *Music) {
+	if i := mm.find(music); i >= 0 {
+		mm.tracks[i].paused = true
+	}
+	music.PauseStream()
+}
+
+//Resume continues playing paused music
+func (mm *MusicManager) Resume(music *Music) {
+	if i := mm.find(music); i >= 0 {
+		mm.tracks[i].paused = false
+	}
+	music.ResumeStream()
+}
+
+//Stop stops the music and removes it from the manager
+func (mm *MusicManager) Stop(music *Music) {
+	mm.Remove(music)
+	music.StopStream()
+}
+
+//Update calls UpdateMusicStream on every registered music. This should be called once per frame.
+// Music that does not loop forever is removed once it has finished playing.
+func (mm *MusicManager) Update() {
+	remaining := mm.tracks[:0]
+	for _, track := range mm.tracks {
+		mm.update(track.music)
+
+		//Finished playing all of its loops
+		if !track.paused && track.music.LoopCount != 0 && !mm.isPlaying(track.music) {
+			continue
+		}
+
+		remaining = append(remaining, track)
+	}
+
+	//Clear the tail so the removed music can be collected
+	for i := len(remaining); i < len(mm.tracks); i++ {
+		mm.tracks[i] = nil
+	}
+	mm.tracks = remaining
+}
+
+//Count is the number of registered music streams
+func (mm *MusicManager) Count() int { return len(mm.tracks) }
+
+//Contains checks if the music is registered
+func (mm *MusicManager) Contains(music *Music) bool { return mm.find(music) >= 0 }
+
+func (mm *MusicManager) find(music *Music) int {
+	for i, track := range mm.tracks {
+		if track.music == music {
+			return i
+		}
+	}
+	return -1
+}
+
+//Crossfade fades one music track out while fading another in, such as when moving between areas of a game.
+// The volumes follow an equal power curve, so the overall loudness stays even through the fade.
+// This only changes the volumes, so both tracks still need their streams updated, such as by a MusicManager.
+type Crossfade struct {
+	From     *Music
+	To       *Music
+	Duration float32
+
+	elapsed  float32
+	complete bool
+
+	setVolume func(music *Music, volume float32)
+	stop      func(music *Music)
+}
+
+//NewCrossfade starts playing the new track silently and begins fading between the two.
+// Either track may be nil to simply fade in or out.
+func NewCrossfade(from, to *Music, duration float32) *Crossfade {
+	return newCrossfade(from, to, duration, SetMusicVolume, PlayMusicStream, StopMusicStream)
+}
+
+//newCrossfade starts the fade, changing the tracks only through the given functions
+func newCrossfade(from, to *Music, duration float32, setVolume func(music *Music, volume float32), play, stop func(music *Music)) *Crossfade {
+	crossfade := &Crossfade{
+		From:      from,
+		To:        to,
+		Duration:  duration,
+		setVolume: setVolume,
+		stop:      stop,
+	}
+
+	if to != nil {
+		crossfade.setVolume(to, 0)
+		play(to)
+	}
+
+	crossfade.apply()
+	return crossfade
+}
+
+//Update advances the fade by the delta time. The old track is stopped once the fade is complete.
+// Returns true when the fade has completed.
+func (crossfade *Crossfade) Update(dt float32) bool {
+	if crossfade.complete {
+		return true
+	}
+
+	crossfade.elapsed += dt
+	crossfade.apply()
+
+	if crossfade.Progress() >= 1 {
+		crossfade.complete = true
+		if crossfade.From != nil {
+			crossfade.stop(crossfade.From)
+		}
+	}
+
+	return crossfade.complete
+}
+
+//apply sets the volume of both tracks for the current progress
+func (crossfade *Crossfade) apply() {
+	fromVolume, toVolume := crossfadeVolumes(crossfade.Progress())
+	if crossfade.From != nil {
+		crossfade.setVolume(crossfade.From, fromVolume)
+	}
+	if crossfade.To != nil {
+		crossfade.setVolume(crossfade.To, toVolume)
+	}
+}
+
+//Progress is how far through the fade we are, between 0 and 1
+func (crossfade *Crossfade) Progress() float32 {
+	if crossfade.Duration <= 0 {
+		return 1
+	}
+	return Clamp32(crossfade.elapsed/crossfade.Duration, 0, 1)
+}
+
+//IsComplete returns true once the fade has finished
+func (crossfade *Crossfade) IsComplete() bool { return crossfade.complete }
+
+//crossfadeVolumes calculates the equal power volumes of the outgoing and incoming tracks
+func crossfadeVolumes(progress float32) (from, to float32) {
+	angle := float64(Clamp32(progress, 0, 1)) * math.Pi / 2
+	return float32(math.Cos(angle)), float32(math.Sin(angle))
+}
@@ -0,0 +1,160 @@
+package raylib
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+//fakeMusicManager creates a manager that records the updates and reads whether music is playing from the map
+func fakeMusicManager(playing map[*Music]bool) (*MusicManager, *[]*Music) {
+	updated := &[]*Music{}
+	mm := NewMusicManager()
+	mm.update = func(music *Music) { *updated = append(*updated, music) }
+	mm.isPlaying = func(music *Music) bool { return playing[music] }
+	return mm, updated
+}
+
+func TestMusicManagerUpdate(t *testing.T) {
+	looping := &Music{LoopCount: 0}
+	once := &Music{LoopCount: 1}
+	paused := &Music{LoopCount: 1}
+	playing := map[*Music]bool{looping: true, once: true}
+
+	mm, updated := fakeMusicManager(playing)
+	mm.Add(looping)
+	mm.Add(once)
+	mm.Add(once)
+	mm.Add(paused)
+	mm.tracks[mm.find(paused)].paused = true
+
+	if mm.Count() != 3 {
+		t.Fatalf("Count() = %d, want 3 as adding twice has no effect", mm.Count())
+	}
+
+	mm.Update()
+	if expected := []*Music{looping, once, paused}; !reflect.DeepEqual(*updated, expected) {
+		t.Errorf("Update() updated %v, want every registered music in order", *updated)
+	}
+
+	//Music that plays once is removed after it stops, while looping and paused music stays
+	*updated = (*updated)[:0]
+	playing[once] = false
+	playing[looping] = false
+	mm.Update()
+	if expected := []*Music{looping, once, paused}; !reflect.DeepEqual(*updated, expected) {
+		t.Errorf("Update() updated %v, want the finished music updated one last time", *updated)
+	}
+	if mm.Contains(once) || !mm.Contains(looping) || !mm.Contains(paused) {
+		t.Errorf("after Update() contains once %v, looping %v, paused %v, want false, true, true", mm.Contains(once), mm.Contains(looping), mm.Contains(paused))
+	}
+
+	*updated = (*updated)[:0]
+	mm.Remove(looping)
+	mm.Update()
+	if expected := []*Music{paused}; !reflect.DeepEqual(*updated, expected) {
+		t.Errorf("Update() after Remove() updated %v, want only the paused music", *updated)
+	}
+}
+
+func TestCrossfadeVolumes(t *testing.T) {
+	tests := []struct {
+		progress float32
+		from, to float32
+	}{
+		{-1, 1, 0},
+		{0, 1, 0},
+		{0.5, 0.70710677, 0.70710677},
+		{1, 0, 1},
+		{2, 0, 1},
+	}
+
+	for _, test := range tests {
+		from, to := crossfadeVolumes(test.progress)
+		if !nearlyEqual(from, test.from) || !nearlyEqual(to, test.to) {
+			t.Errorf("crossfadeVolumes(%v) = %v, %v, want %v, %v", test.progress, from, to, test.from, test.to)
+		}
+		//Equal power keeps the total power constant through the fade
+		if power := from*from + to*to; !nearlyEqual(power, 1) {
+			t.Errorf("crossfadeVolumes(%v) power = %v, want 1", test.progress, power)
+		}
+	}
+}
+
+func TestCrossfadeUpdate(t *testing.T) {
+	from, to := &Music{}, &Music{}
+	volumes := make(map[*Music]float32)
+	calls := make([]string, 0)
+	stopped := make([]*Music, 0)
+
+	setVolume := func(music *Music, volume float32) {
+		volumes[music] = volume
+		if music == to {
+			calls = append(calls, fmt.Sprintf("volume %v", volume))
+		}
+	}
+	play := func(music *Music) {
+		if music == to {
+			calls = append(calls, "play")
+		}
+	}
+	stop := func(music *Music) { stopped = append(stopped, music) }
+	crossfade := newCrossfade(from, to, 2, setVolume, play, stop)
+
+	//The new track is silenced through the setter before it starts playing
+	if len(calls) < 2 || calls[0] != "volume 0" || calls[1] != "play" {
+		t.Errorf("newCrossfade() made the calls %v to the new track, want it silenced then played", calls)
+	}
+	if volumes[from] != 1 || volumes[to] != 0 {
+		t.Errorf("volumes at the start = %v, %v, want 1, 0", volumes[from], volumes[to])
+	}
+
+	var previousFrom, previousTo float32 = 2, -1
+	for step := 0; step < 4; step++ {
+		complete := crossfade.Update(0.5)
+
+		expectedFrom, expectedTo := crossfadeVolumes(float32(step+1) * 0.25)
+		if !nearlyEqual(volumes[from], expectedFrom) || !nearlyEqual(volumes[to], expectedTo) {
+			t.Errorf("step %d: volumes = %v, %v, want %v, %v", step, volumes[from], volumes[to], expectedFrom, expectedTo)
+		}
+		if volumes[from] >= previousFrom || volumes[to] <= previousTo {
+			t.Errorf("step %d: volumes = %v, %v, want the old track quieter and the new track louder", step, volumes[from], volumes[to])
+		}
+		previousFrom, previousTo = volumes[from], volumes[to]
+
+		if complete != (step == 3) {
+			t.Errorf("step %d: Update() = %v, want %v", step, complete, step == 3)
+		}
+	}
+
+	if !reflect.DeepEqual(stopped, []*Music{from}) {
+		t.Errorf("stopped %v, want only the old track", stopped)
+	}
+
+	if !crossfade.Update(1) || len(stopped) != 1 {
+		t.Error("Update() after completing should stay complete without stopping again")
+	}
+}
+
+func TestMusicLooping(t *testing.T) {
+	//The loop count lives on the Go struct, so no audio device is needed
+	var music Music
+	if !music.IsLooping() {
+		t.Error("IsLooping() of new music = false, want true as a count of 0 loops forever")
+	}
+
+	music.SetLooping(false)
+	if music.IsLooping() || music.LoopCount != 1 {
+		t.Errorf("after SetLooping(false), IsLooping() = %v with a count of %d, want false with 1", music.IsLooping(), music.LoopCount)
+	}
+
+	music.SetLooping(true)
+	if !music.IsLooping() || music.LoopCount != 0 {
+		t.Errorf("after SetLooping(true), IsLooping() = %v with a count of %d, want true with 0", music.IsLooping(), music.LoopCount)
+	}
+
+	music.SetLoopCount(-3)
+	if music.LoopCount != 0 {
+		t.Errorf("SetLoopCount(-3) set the count to %d, want 0", music.LoopCount)
+	}
+}
@@ -0,0 +1,147 @@
+package raylib
+
+//pannedSoundFrames is how many frames are streamed at a time, the size of each half of a raylib audio stream buffer
+const pannedSoundFrames = 4096
+
+//PannedSound plays a wave that can be panned between the left and right speakers.
+// raylib cannot pan a Sound, so the wave is streamed through a stereo AudioStream and each channel is scaled in Go.
+// Like Music, Update must be called every frame while it is playing. A new pan is heard from the next buffer streamed.
+type PannedSound struct {
+	Stream  *AudioStream
+	samples []float32 //The wave as stereo samples, with the left and right channels interleaved
+	buffer  []float32 //The panned samples sent to the stream
+	cursor  int       //The next frame to stream
+	drained int       //How many empty buffers have been sent since the wave ran out
+	pan     float32
+	playing bool
+}
+
+//LoadPannedSound creates a sound that can be panned from the wave. Mono waves start playing equally from both speakers.
+// The sound is registered as an Unloadable and owns its stream.
+func LoadPannedSound(wave *Wave) *PannedSound {
+	stream := InitAudioStream(wave.SampleRate, 32, 2)
+	UnregisterUnloadable(stream)
+
+	sound := &PannedSound{
+		Stream:  stream,
+		samples: stereoSamples(GetWaveData(*wave), int(wave.Channels)),
+		buffer:  make([]float32, pannedSoundFrames*2),
+	}
+	RegisterUnloadable(sound)
+	return sound
+}
+
+//Unload stops the sound and frees its stream
+func (sound *PannedSound) Unload() {
+	sound.Stream.Unload()
+	sound.playing = false
+	UnregisterUnloadable(sound)
+}
+
+//SetPan sets the balance between the speakers, from -1 for only the left to 1 for only the right. 0 is the centre.
+func (sound *PannedSound) SetPan(pan float32) {
+	sound.pan = Clamp32(pan, -1, 1)
+}
+
+//Pan gets the balance between the speakers, from -1 for only the left to 1 for only the right
+func (sound *PannedSound) Pan() float32 {
+	return sound.pan
+}
+
+//SetVolume sets the volume of the sound, with 1 as the max level
+func (sound *PannedSound) SetVolume(volume float32) {
+	sound.Stream.SetVolume(Clamp32(volume, 0, 1))
+}
+
+//Play starts the sound from the beginning
+func (sound *PannedSound) Play() {
+	sound.Stream.Stop()
+	sound.cursor, sound.drained = 0, 0
+	sound.playing = true
+	sound.Update()
+	sound.Stream.Play()
+}
+
+//Stop stops the sound. Play starts it from the beginning again.
+func (sound *PannedSound) Stop() {
+	sound.Stream.Stop()
+	sound.playing = false
+}
+
+//Pause pauses the sound, so Resume continues from the same place
+func (sound *PannedSound) Pause() {
+	sound.Stream.Pause()
+}
+
+//Resume continues playing a paused sound
+func (sound *PannedSound) Resume() {
+	sound.Stream.Resume()
+}
+
+//IsPlaying checks if the sound has been played and has not finished or been stopped
+func (sound *PannedSound) IsPlaying() bool {
+	return sound.playing
+}
+
+//Update streams the next part of the sound with the current pan. Call this once per frame while it is playing.
+func (sound *PannedSound) Update() {
+	for sound.playing && sound.Stream.IsProcessed() {
+		//Once the wave runs out, the stream keeps one buffer of silence queued. When that is needed too, the last of the wave has been heard.
+		if sound.cursor*2 >= len(sound.samples) {
+			if sound.drained++; sound.drained > 1 {
+				sound.Stop()
+				return
+			}
+		}
+
+		sound.cursor = panSamples(sound.buffer, sound.samples, sound.cursor, sound.pan)
+		sound.Stream.UpdateFloat32(sound.buffer)
+	}
+}
+
+//panSamples copies the stereo samples starting at the frame into the buffer, scaling each channel by the pan.
+// Any of the buffer past the end of the samples is filled with silence. Returns the frame to continue from.
+func panSamples(buffer, samples []float32, frame int, pan float32) int {
+	left, right := panLevels(pan)
+
+	copied := 0
+	for i := frame * 2; i+1 < len(samples) && copied+1 < len(buffer); i += 2 {
+		buffer[copied] = samples[i] * left
+		buffer[copied+1] = samples[i+1] * right
+		copied += 2
+	}
+	for i := copied; i < len(buffer); i++ {
+		buffer[i] = 0
+	}
+
+	return frame + copied/2
+}
+
+//panLevels gets the gain of the left and right channels for the pan. The side being panned towards stays at full volume while the other fades out.
+func panLevels(pan float32) (left, right float32) {
+	pan = Clamp32(pan, -1, 1)
+	if pan > 0 {
+		return 1 - pan, 1
+	}
+	return 1, 1 + pan
+}
+
+//stereoSamples converts interleaved samples with any number of channels into stereo.
+// Mono samples are copied to both channels, and only the first two channels are kept of anything with more.
+func stereoSamples(samples []float32, channels int) []float32 {
+	if channels <= 0 {
+		return []float32{}
+	}
+
+	frames := len(samples) / channels
+	stereo := make([]float32, frames*2)
+	for f := 0; f < frames; f++ {
+		first := samples[f*channels]
+		second := first
+		if channels > 1 {
+			second = samples[f*channels+1]
+		}
+		stereo[f*2], stereo[f*2+1] = first, second
+	}
+	return stereo
+}
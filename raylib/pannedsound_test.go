@@ -0,0 +1,83 @@
+package raylib
+
+import "testing"
+
+func TestPanLevels(t *testing.T) {
+	tests := []struct {
+		pan         float32
+		left, right float32
+	}{
+		{0, 1, 1},
+		{1, 0, 1},
+		{-1, 1, 0},
+		{0.5, 0.5, 1},
+		{-0.25, 1, 0.75},
+		{3, 0, 1},
+	}
+
+	for _, test := range tests {
+		left, right := panLevels(test.pan)
+		if !nearlyEqual(left, test.left) || !nearlyEqual(right, test.right) {
+			t.Errorf("panLevels(%v) = %v, %v, want %v, %v", test.pan, left, right, test.left, test.right)
+		}
+	}
+}
+
+func TestStereoSamples(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []float32
+		channels int
+		want     []float32
+	}{
+		{"mono", []float32{0.5, -0.5}, 1, []float32{0.5, 0.5, -0.5, -0.5}},
+		{"stereo", []float32{0.5, -0.5, 0.25, 0}, 2, []float32{0.5, -0.5, 0.25, 0}},
+		{"surround", []float32{0.5, -0.5, 1, 0.25, 0, 1}, 3, []float32{0.5, -0.5, 0.25, 0}},
+		{"no channels", []float32{0.5}, 0, []float32{}},
+	}
+
+	for _, test := range tests {
+		stereo := stereoSamples(test.samples, test.channels)
+		if len(stereo) != len(test.want) {
+			t.Errorf("%s: stereoSamples() = %v, want %v", test.name, stereo, test.want)
+			continue
+		}
+		for i := range stereo {
+			if stereo[i] != test.want[i] {
+				t.Errorf("%s: stereoSamples() = %v, want %v", test.name, stereo, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestPanSamples(t *testing.T) {
+	samples := []float32{1, 1, 0.5, 0.5, -1, -1}
+	buffer := make([]float32, 4)
+
+	//The first two frames panned half right, so the left channel is halved
+	next := panSamples(buffer, samples, 0, 0.5)
+	if next != 2 {
+		t.Errorf("panSamples() from frame 0 continues from %d, want 2", next)
+	}
+	for i, want := range []float32{0.5, 1, 0.25, 0.5} {
+		if !nearlyEqual(buffer[i], want) {
+			t.Errorf("sample %d panned right = %v, want %v", i, buffer[i], want)
+		}
+	}
+
+	//Only one frame is left, so the rest of the buffer is silent
+	next = panSamples(buffer, samples, next, -1)
+	if next != 3 {
+		t.Errorf("panSamples() from frame 2 continues from %d, want 3", next)
+	}
+	for i, want := range []float32{-1, 0, 0, 0} {
+		if !nearlyEqual(buffer[i], want) {
+			t.Errorf("sample %d panned left at the end = %v, want %v", i, buffer[i], want)
+		}
+	}
+
+	if next = panSamples(buffer, samples, next, 0); next != 3 {
+		t.Errorf("panSamples() after the end continues from %d, want 3", next)
+	}
+}
@@ -2,7 +2,11 @@ package raylib
 
 //#include "raylib.h"
 import "C"
-import "unsafe"
+import (
+	"image"
+	"math"
+	"unsafe"
+)
 
 /*
 Rectangle Structure
@@ -117,6 +121,19 @@ func (r Rectangle) LerpPosition(pos Vector2, amount float32) Rectangle {
 	}
 }
 
+//RectangleFromImage creates a rectangle from an image.Rectangle
+func RectangleFromImage(rect image.Rectangle) Rectangle {
+	return NewRectangle(float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()))
+}
+
+//ToImageRect converts the rectangle into an image.Rectangle. The edges are rounded outwards, so the result covers every
+// pixel the rectangle touches. Rectangles with negative sizes are returned in the canonical form.
+func (r Rectangle) ToImageRect() image.Rectangle {
+	minX, minY := math.Floor(float64(r.X)), math.Floor(float64(r.Y))
+	maxX, maxY := math.Ceil(float64(r.X+r.Width)), math.Ceil(float64(r.Y+r.Height))
+	return image.Rect(int(minX), int(minY), int(maxX), int(maxY))
+}
+
 type BoundingBox struct {
 	Min Vector3
 	Max Vector3
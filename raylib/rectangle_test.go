@@ -0,0 +1,29 @@
+package raylib
+
+import (
+	"image"
+	"testing"
+)
+
+func TestImageRectRoundTrip(t *testing.T) {
+	for _, rect := range []image.Rectangle{image.Rect(0, 0, 16, 16), image.Rect(-4, 2, 10, 5), image.Rect(3, 3, 3, 3)} {
+		if got := RectangleFromImage(rect).ToImageRect(); got != rect {
+			t.Errorf("RectangleFromImage(%v).ToImageRect() = %v, want %v", rect, got, rect)
+		}
+	}
+
+	tests := []struct {
+		name string
+		rect Rectangle
+		want image.Rectangle
+	}{
+		{"fractional edges round outwards", NewRectangle(0.5, 1.2, 2, 1), image.Rect(0, 1, 3, 3)},
+		{"negative position", NewRectangle(-1.5, -0.5, 1, 1), image.Rect(-2, -1, 0, 1)},
+		{"negative size", NewRectangle(2, 2, -2, -2), image.Rect(0, 0, 2, 2)},
+	}
+	for _, test := range tests {
+		if got := test.rect.ToImageRect(); got != test.want {
+			t.Errorf("%s: %v.ToImageRect() = %v, want %v", test.name, test.rect, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package raylib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+//InputState is a snapshot of the input for a single frame
+type InputState struct {
+	//Dt is the frame time. With a fixed time step this is always the same.
+	Dt float32 `json:"dt"`
+	//KeysDown are the watched keys that were held down
+	KeysDown []Key `json:"keys,omitempty"`
+	//MouseButtonsDown are the mouse buttons that were held down
+	MouseButtonsDown []MouseButton `json:"buttons,omitempty"`
+	//MousePosition is the position of the mouse
+	MousePosition Vector2 `json:"mouse"`
+	//MouseWheel is how far the mouse wheel moved
+	MouseWheel int `json:"wheel,omitempty"`
+}
+
+//CaptureInputState takes a snapshot of the current input. Only the keys given are checked, as checking every key each frame is wasteful.
+func CaptureInputState(dt float32, keys []Key) InputState {
+	state := InputState{
+		Dt:            dt,
+		MousePosition: GetMousePosition(),
+		MouseWheel:    GetMouseWheelMove(),
+	}
+
+	for _, key := range keys {
+		if IsKeyDown(key) {
+			state.KeysDown = append(state.KeysDown, key)
+		}
+	}
+
+	for _, button := range []MouseButton{MouseLeftButton, MouseRightButton, MouseMiddleButton} {
+		if IsMouseButtonDown(button) {
+			state.MouseButtonsDown = append(state.MouseButtonsDown, button)
+		}
+	}
+
+	return state
+}
+
+//IsKeyDown checks if the key was held down
+func (state InputState) IsKeyDown(key Key) bool {
+	for _, k := range state.KeysDown {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+//IsMouseButtonDown checks if the mouse button was held down
+func (state InputState) IsMouseButtonDown(button MouseButton) bool {
+	for _, b := range state.MouseButtonsDown {
+		if b == button {
+			return true
+		}
+	}
+	return false
+}
+
+//Recorder captures the input of every frame so it can be replayed later, such as for demos or reproducing bugs.
+// Replays are only deterministic if the game uses the recorded input and dt instead of reading them directly.
+type Recorder struct {
+	//Keys are the keys that are captured each frame
+	Keys []Key
+
+	frames []InputState
+}
+
+//NewRecorder creates a recorder that captures the keys given
+func NewRecorder(keys []Key) *Recorder {
+	return &Recorder{Keys: keys, frames: make([]InputState, 0)}
+}
+
+//Capture captures the current input and adds it to the recording. This should be called once per frame.
+func (recorder *Recorder) Capture(dt float32) InputState {
+	state := CaptureInputState(dt, recorder.Keys)
+	recorder.Record(state)
+	return state
+}
+
+//Record adds a snapshot to the recording
+func (recorder *Recorder) Record(state InputState) {
+	recorder.frames = append(recorder.frames, state)
+}
+
+//Frames gets every recorded snapshot
+func (recorder *Recorder) Frames() []InputState { return recorder.frames }
+
+//Len is the number of recorded frames
+func (recorder *Recorder) Len() int { return len(recorder.frames) }
+
+//Clear removes every recorded frame
+func (recorder *Recorder) Clear() { recorder.frames = recorder.frames[:0] }
+
+//Save writes the recording to a file
+func (recorder *Recorder) Save(fileName string) error {
+	data, err := json.Marshal(recorder.frames)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, data, 0644)
+}
+
+//LoadRecording reads a recording saved by a Recorder
+func LoadRecording(fileName string) ([]InputState, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var frames []InputState
+	if err := json.Unmarshal(data, &frames); err != nil {
+		return nil, fmt.Errorf("failed to read recording %s: %v", fileName, err)
+	}
+	return frames, nil
+}
+
+//Player feeds recorded input back into the game one frame at a time
+type Player struct {
+	frames   []InputState
+	index    int
+	current  InputState
+	previous InputState
+}
+
+//NewPlayer creates a player for the recorded frames
+func NewPlayer(frames []InputState) *Player {
+	return &Player{frames: frames}
+}
+
+//LoadPlayer creates a player for a recording saved to a file
+func LoadPlayer(fileName string) (*Player, error) {
+	frames, err := LoadRecording(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return NewPlayer(frames), nil
+}
+
+//Next advances to the next recorded frame. This should be called once per frame.
+// Returns false once the recording has finished.
+func (player *Player) Next() (InputState, bool) {
+	if player.index >= len(player.frames) {
+		return InputState{}, false
+	}
+
+	player.previous = player.current
+	player.current = player.frames[player.index]
+	player.index++
+	return player.current, true
+}
+
+//Current is the input of the current frame
+func (player *Player) Current() InputState { return player.current }
+
+//IsKeyDown checks if the key is held down in the current frame
+func (player *Player) IsKeyDown(key Key) bool { return player.current.IsKeyDown(key) }
+
+//IsKeyPressed checks if the key went down this frame
+func (player *Player) IsKeyPressed(key Key) bool {
+	return player.current.IsKeyDown(key) && !player.previous.IsKeyDown(key)
+}
+
+//IsKeyChordPressed checks if the key was pressed this frame while all of the modifiers are held down. See IsKeyChordPressed.
+func (player *Player) IsKeyChordPressed(modifiers []Key, key Key) bool {
+	return isKeyChordPressed(modifiers, key, player.current.IsKeyDown, player.IsKeyPressed)
+}
+
+//IsKeyReleased checks if the key went up this frame
+func (player *Player) IsKeyReleased(key Key) bool {
+	return !player.current.IsKeyDown(key) && player.previous.IsKeyDown(key)
+}
+
+//IsMouseButtonDown checks if the mouse button is held down in the current frame
+func (player *Player) IsMouseButtonDown(button MouseButton) bool {
+	return player.current.IsMouseButtonDown(button)
+}
+
+//IsMouseButtonPressed checks if the mouse button went down this frame
+func (player *Player) IsMouseButtonPressed(button MouseButton) bool {
+	return player.current.IsMouseButtonDown(button) && !player.previous.IsMouseButtonDown(button)
+}
+
+//Frame is the number of frames played so far
+func (player *Player) Frame() int { return player.index }
+
+//Len is the number of frames in the recording
+func (player *Player) Len() int { return len(player.frames) }
+
+//IsFinished returns true once every frame has been played
+func (player *Player) IsFinished() bool { return player.index >= len(player.frames) }
+
+//Reset starts the recording again from the first frame
+func (player *Player) Reset() {
+	player.index = 0
+	player.current = InputState{}
+	player.previous = InputState{}
+}
@@ -0,0 +1,111 @@
+package raylib
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+//testRecording is a few frames of pressing, holding and releasing keys and the mouse
+func testRecording() []InputState {
+	return []InputState{
+		{Dt: 0.016, MousePosition: NewVector2(10, 20)},
+		{Dt: 0.016, KeysDown: []Key{KeyW}, MousePosition: NewVector2(11, 20), MouseWheel: 1},
+		{Dt: 0.017, KeysDown: []Key{KeyW, KeyLeftShift}, MouseButtonsDown: []MouseButton{MouseLeftButton}, MousePosition: NewVector2(12.5, 21)},
+		{Dt: 0.015, KeysDown: []Key{KeyLeftShift}, MouseButtonsDown: []MouseButton{MouseLeftButton, MouseRightButton}, MousePosition: NewVector2(12.5, 21), MouseWheel: -2},
+		{Dt: 0.016, MousePosition: NewVector2(0, 0)},
+	}
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	frames := testRecording()
+	recorder := NewRecorder([]Key{KeyW, KeyLeftShift})
+	for _, frame := range frames {
+		recorder.Record(frame)
+	}
+
+	fileName := filepath.Join(t.TempDir(), "replay.json")
+	if err := recorder.Save(fileName); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	player, err := LoadPlayer(fileName)
+	if err != nil {
+		t.Fatalf("LoadPlayer() failed: %v", err)
+	}
+	if player.Len() != len(frames) {
+		t.Fatalf("Len() = %d, want %d", player.Len(), len(frames))
+	}
+
+	for i, expected := range frames {
+		actual, ok := player.Next()
+		if !ok {
+			t.Fatalf("Next() finished after %d frames, want %d", i, len(frames))
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("frame %d = %+v, want %+v", i, actual, expected)
+		}
+	}
+
+	if _, ok := player.Next(); ok || !player.IsFinished() {
+		t.Error("Next() after the last frame did not finish")
+	}
+}
+
+func TestReplayEdges(t *testing.T) {
+	player := NewPlayer(testRecording())
+
+	steps := []struct {
+		pressed, released, shiftW bool
+		mousePressed              bool
+	}{
+		{false, false, false, false},
+		{true, false, false, false},
+		{false, false, false, true},
+		{false, true, false, false},
+		{false, false, false, false},
+	}
+
+	for i, step := range steps {
+		player.Next()
+		if pressed := player.IsKeyPressed(KeyW); pressed != step.pressed {
+			t.Errorf("frame %d: IsKeyPressed(W) = %v, want %v", i, pressed, step.pressed)
+		}
+		if released := player.IsKeyReleased(KeyW); released != step.released {
+			t.Errorf("frame %d: IsKeyReleased(W) = %v, want %v", i, released, step.released)
+		}
+		if chord := player.IsKeyChordPressed([]Key{KeyLeftShift}, KeyW); chord != step.shiftW {
+			t.Errorf("frame %d: IsKeyChordPressed(Shift+W) = %v, want %v", i, chord, step.shiftW)
+		}
+		if pressed := player.IsMouseButtonPressed(MouseLeftButton); pressed != step.mousePressed {
+			t.Errorf("frame %d: IsMouseButtonPressed(left) = %v, want %v", i, pressed, step.mousePressed)
+		}
+	}
+
+	player.Reset()
+	if frame, ok := player.Next(); !ok || player.Frame() != 1 || frame.MousePosition != NewVector2(10, 20) {
+		t.Errorf("Next() after Reset() = %+v on frame %d, want the first frame", frame, player.Frame())
+	}
+}
+
+func TestReplayChord(t *testing.T) {
+	player := NewPlayer([]InputState{
+		{KeysDown: []Key{KeyLeftShift}},
+		{KeysDown: []Key{KeyLeftShift, KeyW}},
+	})
+
+	player.Next()
+	if player.IsKeyChordPressed([]Key{KeyLeftShift}, KeyW) {
+		t.Error("IsKeyChordPressed(Shift+W) with only Shift held = true, want false")
+	}
+	player.Next()
+	if !player.IsKeyChordPressed([]Key{KeyLeftShift}, KeyW) {
+		t.Error("IsKeyChordPressed(Shift+W) when W is pressed with Shift held = false, want true")
+	}
+}
+
+func TestLoadRecordingInvalid(t *testing.T) {
+	if _, err := LoadRecording(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadRecording() of a missing file did not fail")
+	}
+}
@@ -0,0 +1,64 @@
+package raylib
+
+//ResizeDebounce is how long in seconds the window size has to stay the same before the resize callbacks are invoked.
+// This stops the callbacks being spammed while the user is dragging the edge of the window.
+var ResizeDebounce = 0.1
+
+var resizeCallbacks []func(width, height int)
+var resizeWatcher = &resizeTracker{}
+
+//resizeTracker keeps track of the window size and waits for it to settle before dispatching
+type resizeTracker struct {
+	width, height int
+	pending       bool
+	changedAt     float64
+	initialized   bool
+}
+
+//update checks the current size, returning true when a resize has settled and should be dispatched.
+func (tracker *resizeTracker) update(width, height int, now, debounce float64) bool {
+	if !tracker.initialized {
+		tracker.width, tracker.height = width, height
+		tracker.initialized = true
+		return false
+	}
+
+	if width != tracker.width || height != tracker.height {
+		tracker.width, tracker.height = width, height
+		tracker.pending = true
+		tracker.changedAt = now
+		return false
+	}
+
+	if tracker.pending && now-tracker.changedAt >= debounce {
+		tracker.pending = false
+		return true
+	}
+
+	return false
+}
+
+//OnResize registers a callback that is invoked with the new size after the window has been resized.
+// PollEvents must be called every frame for the callbacks to be invoked.
+func OnResize(fn func(width, height int)) {
+	resizeCallbacks = append(resizeCallbacks, fn)
+}
+
+//ClearResizeCallbacks removes all the callbacks registered with OnResize
+func ClearResizeCallbacks() {
+	resizeCallbacks = nil
+}
+
+//PollEvents checks for changes to the window and dispatches the registered callbacks. Call this once per frame.
+func PollEvents() {
+	pollResize(GetScreenWidth(), GetScreenHeight(), GetTime())
+}
+
+//pollResize updates the tracker with the window size and invokes the callbacks once the resize has settled
+func pollResize(width, height int, now float64) {
+	if resizeWatcher.update(width, height, now, ResizeDebounce) {
+		for _, fn := range resizeCallbacks {
+			fn(resizeWatcher.width, resizeWatcher.height)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package raylib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResizeTrackerDebounce(t *testing.T) {
+	tracker := &resizeTracker{}
+
+	steps := []struct {
+		width, height int
+		now           float64
+		dispatch      bool
+	}{
+		{800, 600, 0, false},
+		{800, 600, 1, false},
+		{900, 600, 1.1, false},
+		{1000, 700, 1.15, false},
+		{1000, 700, 1.2, false},
+		{1000, 700, 1.25, true},
+		{1000, 700, 2, false},
+	}
+
+	for i, step := range steps {
+		if actual := tracker.update(step.width, step.height, step.now, 0.1); actual != step.dispatch {
+			t.Errorf("step %d: update(%d, %d, %v) = %v, want %v", i, step.width, step.height, step.now, actual, step.dispatch)
+		}
+	}
+}
+
+func TestResizeDispatch(t *testing.T) {
+	previousWatcher := resizeWatcher
+	defer func() {
+		resizeWatcher = previousWatcher
+		ClearResizeCallbacks()
+	}()
+	resizeWatcher = &resizeTracker{}
+
+	sizes := make([][2]int, 0)
+	OnResize(func(width, height int) { sizes = append(sizes, [2]int{width, height}) })
+	OnResize(func(width, height int) { sizes = append(sizes, [2]int{-width, -height}) })
+
+	pollResize(800, 600, 0)
+	pollResize(1024, 768, 1)
+	pollResize(1024, 768, 1+ResizeDebounce)
+	pollResize(1024, 768, 5)
+
+	if expected := [][2]int{{1024, 768}, {-1024, -768}}; !reflect.DeepEqual(sizes, expected) {
+		t.Errorf("callbacks received %v, want %v", sizes, expected)
+	}
+
+	ClearResizeCallbacks()
+	pollResize(640, 480, 6)
+	pollResize(640, 480, 7)
+	if len(sizes) != 2 {
+		t.Errorf("callbacks received %v after ClearResizeCallbacks(), want no more", sizes[2:])
+	}
+}
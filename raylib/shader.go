@@ -3,7 +3,10 @@ package raylib
 //#include "raylib.h"
 //#include <stdlib.h>
 import "C"
-import "unsafe"
+import (
+	"sync"
+	"unsafe"
+)
 
 const MaxShaderLocations = 32
 const MaxMaterialMaps = 12
@@ -36,11 +39,9 @@ const (
 	UniformSampler2D
 )
 
-// Shader location point type
+//ShaderLocationIndex is the index into a shader's Locs for the built-in uniforms and attributes, matching raylib's enum.
 type ShaderLocationIndex int32
 
-//https://dencode.com/en/string/camel-case this is Unsucesfully
-
 const (
 	LocVertexPosition ShaderLocationIndex = iota
 	LocVertexTexcoord01
@@ -56,8 +57,8 @@ const (
 	LocColorDiffuse
 	LocColorSpecular
 	LocColorAmbient
-	LocMapAlbedoLocMapDiffuse
-	LocMapMetalnessLocMapSpecular
+	LocMapAlbedo
+	LocMapMetalness
 	LocMapNormal
 	LocMapRoughness
 	LocMapOcclusion
@@ -69,6 +70,129 @@ const (
 	LocMapBrdf
 )
 
+const (
+	//LocMapDiffuse is an alias of LocMapAlbedo
+	LocMapDiffuse = LocMapAlbedo
+	//LocMapSpecular is an alias of LocMapMetalness
+	LocMapSpecular = LocMapMetalness
+
+	//Deprecated: Use LocMapAlbedo
+	LocMapAlbedoLocMapDiffuse = LocMapAlbedo
+	//Deprecated: Use LocMapMetalness
+	LocMapMetalnessLocMapSpecular = LocMapMetalness
+)
+
+//SetLocation sets the uniform location used for one of the built-in locations.
+// This allows a custom shader to use its own uniform names for values raylib sets, such as the model matrix.
+func (s Shader) SetLocation(index ShaderLocationIndex, loc int) {
+	if s.Locs == nil || index < 0 || int(index) >= MaxShaderLocations {
+		return
+	}
+	s.Locs[index] = int32(loc)
+}
+
+//GetBuiltinLocation gets the uniform location used for one of the built-in locations, or -1 if it is not set.
+func (s Shader) GetBuiltinLocation(index ShaderLocationIndex) int {
+	if s.Locs == nil || index < 0 || int(index) >= MaxShaderLocations {
+		return -1
+	}
+	return int(s.Locs[index])
+}
+
+//LoadShaderFromMemory compiles a shader from the source code of its stages, such as shaders generated at runtime.
+// An empty string uses the default shader for that stage. The shader is registered as an Unloadable.
+// This is the same as LoadShaderCode.
+func LoadShaderFromMemory(vsCode, fsCode string) Shader {
+	return LoadShaderCode(vsCode, fsCode)
+}
+
+//shaderUniforms caches the uniform locations looked up by name for the SetUniform functions, by the shader id
+var shaderUniforms = make(map[uint32]map[string]int)
+var shaderUniformsMutex sync.Mutex
+
+//GetUniformLocation gets the location of the uniform, caching it so the shader is only asked once for each name.
+// Returns -1 and logs a warning the first time if the shader does not have the uniform.
+func (s Shader) GetUniformLocation(name string) int {
+	shaderUniformsMutex.Lock()
+	defer shaderUniformsMutex.Unlock()
+
+	uniforms, ok := shaderUniforms[s.Id]
+	if !ok {
+		uniforms = make(map[string]int)
+		shaderUniforms[s.Id] = uniforms
+	}
+
+	loc, ok := uniforms[name]
+	if !ok {
+		loc = s.GetLocation(name)
+		uniforms[name] = loc
+		if loc < 0 {
+			TraceLog(LogWarning, "[SHADER] Shader ", s.Id, " has no uniform named ", name)
+		}
+	}
+	return loc
+}
+
+//clearShaderUniforms forgets the cached uniform locations of the shader, as the id may be reused once it is unloaded
+func clearShaderUniforms(s Shader) {
+	shaderUniformsMutex.Lock()
+	defer shaderUniformsMutex.Unlock()
+	delete(shaderUniforms, s.Id)
+}
+
+//SetUniformFloat sets a float uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformFloat(name string, value float32) {
+	s.setUniform(name, []float32{value}, UniformFloat)
+}
+
+//SetUniformVec2 sets a vec2 uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformVec2(name string, value Vector2) {
+	s.setUniform(name, []float32{value.X, value.Y}, UniformVec2)
+}
+
+//SetUniformVec3 sets a vec3 uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformVec3(name string, value Vector3) {
+	s.setUniform(name, []float32{value.X, value.Y, value.Z}, UniformVec3)
+}
+
+//SetUniformVec4 sets a vec4 uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformVec4(name string, value Vector4) {
+	s.setUniform(name, []float32{value.X, value.Y, value.Z, value.W}, UniformVec4)
+}
+
+//SetUniformColor sets a vec4 uniform by name to the normalized colour. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformColor(name string, color Color) {
+	s.SetUniformVec4(name, color.Normalize())
+}
+
+//SetUniformInt sets an int uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformInt(name string, value int32) {
+	if loc := s.GetUniformLocation(name); loc >= 0 {
+		s.SetValueInt32(loc, []int32{value}, UniformInt)
+	}
+}
+
+//SetUniformMatrix sets a mat4 uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformMatrix(name string, value Matrix) {
+	if loc := s.GetUniformLocation(name); loc >= 0 {
+		s.SetValueMatrix(loc, value)
+	}
+}
+
+//SetUniformTexture sets a sampler2D uniform by name. Does nothing if the shader does not have the uniform.
+func (s Shader) SetUniformTexture(name string, texture Texture2D) {
+	if loc := s.GetUniformLocation(name); loc >= 0 {
+		s.SetValueTexture(loc, texture)
+	}
+}
+
+//setUniform sets a float uniform by name
+func (s Shader) setUniform(name string, values []float32, uniformType ShaderUniformDataType) {
+	if loc := s.GetUniformLocation(name); loc >= 0 {
+		s.SetValueFloat32(loc, values, uniformType)
+	}
+}
+
 // BlendMode type
 type BlendMode int32
 
@@ -19,6 +19,7 @@ import (
 //LoadText load chars array from text file
 func LoadText(fileName string) string { return "" }
 */
+
 //LoadShader : Load shader from files and bind default locations
 func LoadShader(vsFileName string, fsFileName string) Shader {
 	cfsFileName := C.CString(fsFileName)
@@ -31,12 +32,18 @@ func LoadShader(vsFileName string, fsFileName string) Shader {
 	return retval
 }
 
-//LoadShaderCode : Load shader from code strings and bind default locations
+//LoadShaderCode : Load shader from code strings and bind default locations.
+// An empty string uses the default shader for that stage.
 func LoadShaderCode(vsCode string, fsCode string) Shader {
-	cfsCode := C.CString(fsCode)
-	defer C.free(unsafe.Pointer(cfsCode))
-	cvsCode := C.CString(vsCode)
-	defer C.free(unsafe.Pointer(cvsCode))
+	var cvsCode, cfsCode *C.char
+	if vsCode != "" {
+		cvsCode = C.CString(vsCode)
+		defer C.free(unsafe.Pointer(cvsCode))
+	}
+	if fsCode != "" {
+		cfsCode = C.CString(fsCode)
+		defer C.free(unsafe.Pointer(cfsCode))
+	}
 	res := C.LoadShaderCode(cvsCode, cfsCode)
 	retval := newShaderFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -45,6 +52,7 @@ func LoadShaderCode(vsCode string, fsCode string) Shader {
 
 //Unload : Unload shader from GPU memory (VRAM)
 func (shader Shader) Unload() {
+	clearShaderUniforms(shader)
 	cshader := *shader.cptr()
 	C.UnloadShader(cshader)
 	UnregisterUnloadable(shader)
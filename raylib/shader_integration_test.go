@@ -0,0 +1,101 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import "testing"
+
+//valueFragmentShader outputs the value uniform as the red channel
+const valueFragmentShader = `#version 330
+out vec4 finalColor;
+uniform float value;
+void main() { finalColor = vec4(value, 0.0, 0.0, 1.0); }
+`
+
+func TestSetUniformFloat(t *testing.T) {
+	requireWindow(t)
+
+	var first, second int
+	var pixel Color
+	onMainThread(func() {
+		shader := LoadShaderFromMemory("", valueFragmentShader)
+		defer shader.Unload()
+
+		first = shader.GetUniformLocation("value")
+		second = shader.GetUniformLocation("value")
+		shader.SetUniformFloat("value", 0.5)
+
+		//Read the value back by drawing with it
+		BeginDrawing()
+		ClearBackground(Black)
+		BeginShaderMode(shader)
+		DrawRectangle(0, 0, integrationWidth, integrationHeight, White)
+		EndShaderMode()
+		EndDrawing()
+		pixel = ReadFramebufferPixel(integrationWidth/2, integrationHeight/2)
+	})
+
+	if first < 0 || first != second {
+		t.Errorf("GetUniformLocation() = %d then %d, want the same valid location", first, second)
+	}
+	if pixel.R < 126 || pixel.R > 129 || pixel.G != 0 || pixel.B != 0 {
+		t.Errorf("pixel drawn with the uniform = %v, want a red of 0.5", pixel)
+	}
+}
+
+//passthroughVertexShader and passthroughFragmentShader draw the same as raylib's default shader
+const passthroughVertexShader = `#version 330
+in vec3 vertexPosition;
+in vec2 vertexTexCoord;
+in vec4 vertexColor;
+out vec2 fragTexCoord;
+out vec4 fragColor;
+uniform mat4 mvp;
+void main() {
+	fragTexCoord = vertexTexCoord;
+	fragColor = vertexColor;
+	gl_Position = mvp*vec4(vertexPosition, 1.0);
+}
+`
+
+const passthroughFragmentShader = `#version 330
+in vec2 fragTexCoord;
+in vec4 fragColor;
+out vec4 finalColor;
+uniform sampler2D texture0;
+uniform vec4 colDiffuse;
+void main() { finalColor = texture(texture0, fragTexCoord)*colDiffuse*fragColor; }
+`
+
+func TestLoadShaderFromMemory(t *testing.T) {
+	requireWindow(t)
+
+	var shader, defaultShader Shader
+	var mvp int
+	var pixel Color
+	onMainThread(func() {
+		shader = LoadShaderFromMemory(passthroughVertexShader, passthroughFragmentShader)
+		defer shader.Unload()
+		defaultShader = GetShaderDefault()
+		mvp = shader.GetBuiltinLocation(LocMatrixMvp)
+
+		BeginDrawing()
+		ClearBackground(Black)
+		BeginShaderMode(shader)
+		DrawRectangle(8, 8, 16, 16, Red)
+		EndShaderMode()
+		EndDrawing()
+		pixel = ReadFramebufferPixel(16, 16)
+	})
+
+	//A shader that fails to compile falls back to the default shader
+	if shader.Id == 0 || shader.Id == defaultShader.Id {
+		t.Fatalf("LoadShaderFromMemory() = shader %d, want a new shader rather than the default %d", shader.Id, defaultShader.Id)
+	}
+	if mvp < 0 {
+		t.Errorf("GetBuiltinLocation(LocMatrixMvp) = %d, want the location of mvp", mvp)
+	}
+	if pixel != Red {
+		t.Errorf("pixel drawn with the passthrough shader = %v, want %v", pixel, Red)
+	}
+}
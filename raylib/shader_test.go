@@ -0,0 +1,119 @@
+package raylib
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGetUniformLocationCache(t *testing.T) {
+	//The cache is filled directly, as looking up a location needs an OpenGL context
+	shader := Shader{Id: 9999}
+	shaderUniformsMutex.Lock()
+	shaderUniforms[shader.Id] = map[string]int{"value": 3, "missing": -1}
+	shaderUniformsMutex.Unlock()
+	defer clearShaderUniforms(shader)
+
+	if loc := shader.GetUniformLocation("value"); loc != 3 {
+		t.Errorf("GetUniformLocation() of a cached uniform = %d, want 3", loc)
+	}
+	if loc := shader.GetUniformLocation("missing"); loc != -1 {
+		t.Errorf("GetUniformLocation() of a cached missing uniform = %d, want -1", loc)
+	}
+
+	clearShaderUniforms(shader)
+	shaderUniformsMutex.Lock()
+	_, ok := shaderUniforms[shader.Id]
+	shaderUniformsMutex.Unlock()
+	if ok {
+		t.Error("clearShaderUniforms() left the cached locations")
+	}
+}
+
+//headerEnum reads the names of an enum from raylib.h in the order of their values. The enum must not skip values.
+func headerEnum(t *testing.T, name string) []string {
+	t.Helper()
+
+	header, err := ioutil.ReadFile("raylib.h")
+	if err != nil {
+		t.Fatalf("failed to read raylib.h: %v", err)
+	}
+
+	end := strings.Index(string(header), "} "+name+";")
+	if end < 0 {
+		t.Fatalf("raylib.h has no enum %s", name)
+	}
+	start := strings.LastIndex(string(header[:end]), "{")
+
+	names := make([]string, 0)
+	for _, line := range strings.Split(string(header[start+1:end]), "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		for _, entry := range strings.Split(line, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			if i := strings.Index(entry, "="); i >= 0 {
+				if value := strings.TrimSpace(entry[i+1:]); value != strconv.Itoa(len(names)) {
+					t.Fatalf("%s in raylib.h has the value %s, want %d", entry, value, len(names))
+				}
+				entry = strings.TrimSpace(entry[:i])
+			}
+			names = append(names, entry)
+		}
+	}
+	return names
+}
+
+func TestShaderLocationIndex(t *testing.T) {
+	locations := map[string]ShaderLocationIndex{
+		"LOC_VERTEX_POSITION":   LocVertexPosition,
+		"LOC_VERTEX_TEXCOORD01": LocVertexTexcoord01,
+		"LOC_VERTEX_TEXCOORD02": LocVertexTexcoord02,
+		"LOC_VERTEX_NORMAL":     LocVertexNormal,
+		"LOC_VERTEX_TANGENT":    LocVertexTangent,
+		"LOC_VERTEX_COLOR":      LocVertexColor,
+		"LOC_MATRIX_MVP":        LocMatrixMvp,
+		"LOC_MATRIX_MODEL":      LocMatrixModel,
+		"LOC_MATRIX_VIEW":       LocMatrixView,
+		"LOC_MATRIX_PROJECTION": LocMatrixProjection,
+		"LOC_VECTOR_VIEW":       LocVectorView,
+		"LOC_COLOR_DIFFUSE":     LocColorDiffuse,
+		"LOC_COLOR_SPECULAR":    LocColorSpecular,
+		"LOC_COLOR_AMBIENT":     LocColorAmbient,
+		"LOC_MAP_ALBEDO":        LocMapAlbedo,
+		"LOC_MAP_METALNESS":     LocMapMetalness,
+		"LOC_MAP_NORMAL":        LocMapNormal,
+		"LOC_MAP_ROUGHNESS":     LocMapRoughness,
+		"LOC_MAP_OCCLUSION":     LocMapOcclusion,
+		"LOC_MAP_EMISSION":      LocMapEmission,
+		"LOC_MAP_HEIGHT":        LocMapHeight,
+		"LOC_MAP_CUBEMAP":       LocMapCubemap,
+		"LOC_MAP_IRRADIANCE":    LocMapIrradiance,
+		"LOC_MAP_PREFILTER":     LocMapPrefilter,
+		"LOC_MAP_BRDF":          LocMapBrdf,
+	}
+
+	names := headerEnum(t, "ShaderLocationIndex")
+	if len(names) != len(locations) {
+		t.Errorf("raylib.h has %d shader locations, want %d", len(names), len(locations))
+	}
+	for value, name := range names {
+		location, ok := locations[name]
+		if !ok {
+			t.Errorf("raylib.h has %s which has no matching constant", name)
+		} else if int(location) != value {
+			t.Errorf("constant for %s = %d, want %d", name, location, value)
+		}
+		if value >= MaxShaderLocations {
+			t.Errorf("%s = %d does not fit within MaxShaderLocations", name, value)
+		}
+	}
+
+	if LocMapDiffuse != LocMapAlbedo || LocMapSpecular != LocMapMetalness {
+		t.Errorf("LocMapDiffuse = %d and LocMapSpecular = %d, want the same as LocMapAlbedo and LocMapMetalness", LocMapDiffuse, LocMapSpecular)
+	}
+}
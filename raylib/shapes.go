@@ -1,5 +1,44 @@
 package raylib
 
+/*
+#include "raylib.h"
+#include "rlgl.h"
+
+//Draws triangles with a colour for each vertex. The count is the number of vertices.
+static void Go_DrawGradientTriangles(Vector2 *points, Color *colors, int count) {
+	if (rlCheckBufferLimit(count)) rlglDraw();
+
+	rlBegin(RL_TRIANGLES);
+	for (int i = 0; i < count; i++) {
+		rlColor4ub(colors[i].r, colors[i].g, colors[i].b, colors[i].a);
+		rlVertex2f(points[i].x, points[i].y);
+	}
+	rlEnd();
+}
+
+//Reads a point back from an array of points
+static Vector2 Go_Vector2At(Vector2 *points, int index) {
+	return points[index];
+}
+*/
+import "C"
+import (
+	"math"
+	"unsafe"
+)
+
+//vector2SliceToC gives C a pointer to the first point and the number of points.
+// Vector2 has the same layout as C's Vector2, so the slice is passed without copying. The slice must not be empty.
+func vector2SliceToC(points []Vector2) (*C.Vector2, C.int) {
+	return points[0].cptr(), C.int(int32(len(points)))
+}
+
+//vector2AtC reads the point at the index from a C array of points
+func vector2AtC(points *C.Vector2, index int) Vector2 {
+	res := C.Go_Vector2At(points, C.int(int32(index)))
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}
+
 //DrawRectangleGradientV : Draw a vertical-gradient-filled rectangle
 func DrawRectangleGradientVRec(rect Rectangle, color1 Color, color2 Color) {
 	DrawRectangleGradientEx(rect, color1, color2, color2, color1)
@@ -9,3 +48,355 @@ func DrawRectangleGradientVRec(rect Rectangle, color1 Color, color2 Color) {
 func DrawRectangleGradientHRec(rect Rectangle, color1 Color, color2 Color) {
 	DrawRectangleGradientEx(rect, color1, color1, color2, color2)
 }
+
+//DrawProgressBar draws a horizontal bar filled from the left by how far the value is between min and max.
+// The value is clamped to the range, and if min and max are equal the bar is either empty or full.
+func DrawProgressBar(bounds Rectangle, value, min, max float32, bg, fg Color) {
+	DrawRectangleRec(bounds, bg)
+
+	fill := progressFillWidth(bounds.Width, value, min, max)
+	if fill > 0 {
+		DrawRectangleRec(NewRectangle(bounds.X, bounds.Y, fill, bounds.Height), fg)
+	}
+}
+
+//DrawProgressBarTexture draws a horizontal bar using textures. The background is stretched across the whole bounds,
+// while the foreground is cut off at the fill so it is revealed rather than squashed as the value grows.
+func DrawProgressBarTexture(bounds Rectangle, value, min, max float32, background Texture2D, backgroundRec Rectangle, foreground Texture2D, foregroundRec Rectangle, tint Color) {
+	DrawTexturePro(background, backgroundRec, bounds, Vector2{}, 0, tint)
+
+	if bounds.Width == 0 {
+		return
+	}
+
+	fill := progressFillWidth(bounds.Width, value, min, max)
+	if fill <= 0 {
+		return
+	}
+
+	//Only take the same portion of the source as we are filling
+	source := foregroundRec
+	source.Width = foregroundRec.Width * (fill / bounds.Width)
+	DrawTexturePro(foreground, source, NewRectangle(bounds.X, bounds.Y, fill, bounds.Height), Vector2{}, 0, tint)
+}
+
+//progressFillWidth calculates how much of the width is filled by the value
+func progressFillWidth(width, value, min, max float32) float32 {
+	if max < min {
+		min, max = max, min
+	}
+
+	if max == min {
+		if value >= max {
+			return width
+		}
+		return 0
+	}
+
+	return width * Clamp32((value-min)/(max-min), 0, 1)
+}
+
+//DrawCircleGradientV draws a circle that fades from the inner colour at the center to the outer colour at the edge.
+// The number of segments controls how smooth the circle is, and is at least 3.
+func DrawCircleGradientV(center Vector2, radius float32, segments int, inner, outer Color) {
+	drawGradientTriangles(circleGradientVertices(center, radius, segments, inner, outer))
+}
+
+//circleGradientVertices gets the triangles and the colour of each vertex for DrawCircleGradientV
+func circleGradientVertices(center Vector2, radius float32, segments int, inner, outer Color) ([]Vector2, []Color) {
+	if segments < 3 {
+		segments = 3
+	}
+
+	points := make([]Vector2, 0, segments*3)
+	colors := make([]Color, 0, segments*3)
+	step := 2 * math.Pi / float64(segments)
+	for i := 0; i < segments; i++ {
+		a1, a2 := step*float64(i), step*float64(i+1)
+		points = append(points,
+			center,
+			NewVector2(center.X+float32(math.Sin(a1))*radius, center.Y+float32(math.Cos(a1))*radius),
+			NewVector2(center.X+float32(math.Sin(a2))*radius, center.Y+float32(math.Cos(a2))*radius))
+		colors = append(colors, inner, outer, outer)
+	}
+
+	return points, colors
+}
+
+//DrawRectangleGradientRadial draws a rectangle that fades from the inner colour at its center to the outer colour at its corners.
+// This is useful for vignettes and glows. It is drawn as a small grid, with the colour of each point based on its distance.
+func DrawRectangleGradientRadial(rec Rectangle, inner, outer Color) {
+	drawGradientTriangles(radialGradientVertices(rec, inner, outer))
+}
+
+//radialGradientVertices gets the triangles and the colour of each vertex for DrawRectangleGradientRadial
+func radialGradientVertices(rec Rectangle, inner, outer Color) ([]Vector2, []Color) {
+	const divisions = 8
+
+	center := rec.Center()
+	radius := rec.Size().Scale(0.5).Length()
+
+	//Calculate the colour of every point on the grid
+	var grid [divisions + 1][divisions + 1]Vector2
+	var gridColors [divisions + 1][divisions + 1]Color
+	for y := 0; y <= divisions; y++ {
+		for x := 0; x <= divisions; x++ {
+			point := NewVector2(rec.X+rec.Width*float32(x)/divisions, rec.Y+rec.Height*float32(y)/divisions)
+			grid[x][y] = point
+			gridColors[x][y] = radialGradientColor(point, center, radius, inner, outer)
+		}
+	}
+
+	points := make([]Vector2, 0, divisions*divisions*6)
+	colors := make([]Color, 0, divisions*divisions*6)
+	for y := 0; y < divisions; y++ {
+		for x := 0; x < divisions; x++ {
+			//Top left, bottom left, top right then top right, bottom left, bottom right
+			points = append(points, grid[x][y], grid[x][y+1], grid[x+1][y], grid[x+1][y], grid[x][y+1], grid[x+1][y+1])
+			colors = append(colors, gridColors[x][y], gridColors[x][y+1], gridColors[x+1][y], gridColors[x+1][y], gridColors[x][y+1], gridColors[x+1][y+1])
+		}
+	}
+
+	return points, colors
+}
+
+//radialGradientColor gets the colour of a point based on how far it is from the center, reaching the outer colour at the radius
+func radialGradientColor(point, center Vector2, radius float32, inner, outer Color) Color {
+	if radius <= 0 {
+		return outer
+	}
+	return inner.Lerp(outer, Clamp32(point.Distance(center)/radius, 0, 1))
+}
+
+//drawGradientTriangles draws triangles where every vertex has its own colour
+func drawGradientTriangles(points []Vector2, colors []Color) {
+	if len(points) < 3 || len(points) != len(colors) {
+		return
+	}
+	C.Go_DrawGradientTriangles(points[0].cptr(), colors[0].cptr(), C.int(int32(len(points))))
+}
+
+//DrawRectangleRoundedEx draws a rectangle where each corner has its own radius in pixels, in the order top-left, top-right,
+// bottom-right then bottom-left. Radii are clamped between 0 and half the smallest side of the rectangle.
+// The segments are per corner, and if less than 1 they are picked based on the size of each corner.
+// The shape is drawn as a single triangle fan, so translucent colours do not overlap like separate sectors and rectangles would.
+func DrawRectangleRoundedEx(rec Rectangle, radii [4]float32, segments int, color Color) {
+	outline := roundedRectangleOutline(rec, radii, segments)
+	if len(outline) < 3 {
+		return
+	}
+
+	//The shape is convex, so it can be fanned from its center. The first point is repeated to close the shape.
+	points := make([]Vector2, 0, len(outline)+2)
+	points = append(points, rec.Center())
+	points = append(points, outline...)
+	points = append(points, outline[0])
+	DrawTriangleFan(points, color)
+}
+
+//roundedRectangleOutline gets the outline of a rectangle with rounded corners, counter-clockwise on the screen
+// starting at the top of the top-left corner. The radii are in the order top-left, top-right, bottom-right then bottom-left.
+func roundedRectangleOutline(rec Rectangle, radii [4]float32, segments int) []Vector2 {
+	if rec.Width <= 0 || rec.Height <= 0 {
+		return nil
+	}
+
+	limit := rec.Width / 2
+	if rec.Height/2 < limit {
+		limit = rec.Height / 2
+	}
+
+	//Corners in drawing order, with the angle each arc starts from. Angles are in degrees with Y pointing down.
+	corners := [4]struct {
+		radius float32
+		x, y   float32
+		sx, sy float32
+		start  float64
+	}{
+		{radii[0], rec.X, rec.Y, 1, 1, 270},
+		{radii[3], rec.X, rec.Y + rec.Height, 1, -1, 180},
+		{radii[2], rec.X + rec.Width, rec.Y + rec.Height, -1, -1, 90},
+		{radii[1], rec.X + rec.Width, rec.Y, -1, 1, 0},
+	}
+
+	points := make([]Vector2, 0)
+	for _, corner := range corners {
+		radius := Clamp32(corner.radius, 0, limit)
+		if radius == 0 {
+			points = append(points, NewVector2(corner.x, corner.y))
+			continue
+		}
+
+		center := NewVector2(corner.x+corner.sx*radius, corner.y+corner.sy*radius)
+		count := roundedCornerSegments(radius, segments)
+		for i := 0; i <= count; i++ {
+			angle := (corner.start - 90*float64(i)/float64(count)) * math.Pi / 180
+			points = append(points, NewVector2(center.X+radius*float32(math.Cos(angle)), center.Y+radius*float32(math.Sin(angle))))
+		}
+	}
+
+	return points
+}
+
+//roundedCornerSegments picks the number of segments for a quarter circle, the same way raylib does for rounded rectangles
+func roundedCornerSegments(radius float32, segments int) int {
+	if segments >= 1 {
+		return segments
+	}
+
+	const errorRate = 0.5
+	if radius <= errorRate {
+		return 1
+	}
+
+	th := math.Acos(2*math.Pow(1-errorRate/float64(radius), 2) - 1)
+	count := int(math.Ceil(2*math.Pi/th) / 4)
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+//DrawPolygon fills a simple polygon, which may be concave. The points can be in either winding order.
+// Repeated and collinear points are skipped, and polygons that cross over themselves are not drawn.
+func DrawPolygon(points []Vector2, color Color) {
+	triangles := triangulatePolygon(points)
+	for i := 0; i+2 < len(triangles); i += 3 {
+		DrawTriangle(triangles[i], triangles[i+1], triangles[i+2], color)
+	}
+}
+
+//triangulatePolygon splits a simple polygon into triangles using ear clipping. Every 3 points is a triangle,
+// counter-clockwise on the screen as raylib expects. Returns nil if the polygon is degenerate or crosses over itself.
+func triangulatePolygon(points []Vector2) []Vector2 {
+	polygon := simplifyPolygon(points)
+	if len(polygon) < 3 || polygonSelfIntersects(polygon) {
+		return nil
+	}
+
+	//Counter-clockwise on the screen has a negative area, as Y points down
+	if polygonArea(polygon) > 0 {
+		for i, j := 0, len(polygon)-1; i < j; i, j = i+1, j-1 {
+			polygon[i], polygon[j] = polygon[j], polygon[i]
+		}
+	}
+
+	triangles := make([]Vector2, 0, (len(polygon)-2)*3)
+	for len(polygon) > 3 {
+		ear := -1
+		for i := range polygon {
+			prev, curr, next := polygon[(i+len(polygon)-1)%len(polygon)], polygon[i], polygon[(i+1)%len(polygon)]
+			if isPolygonEar(polygon, prev, curr, next) {
+				ear = i
+				break
+			}
+		}
+
+		//Floating point errors can leave no ears, which only happens with nearly degenerate polygons
+		if ear < 0 {
+			return nil
+		}
+
+		prev, next := (ear+len(polygon)-1)%len(polygon), (ear+1)%len(polygon)
+		triangles = append(triangles, polygon[prev], polygon[ear], polygon[next])
+		polygon = append(polygon[:ear], polygon[ear+1:]...)
+	}
+
+	return append(triangles, polygon[0], polygon[1], polygon[2])
+}
+
+//simplifyPolygon copies the points without any repeated or collinear points
+func simplifyPolygon(points []Vector2) []Vector2 {
+	polygon := make([]Vector2, 0, len(points))
+	for _, point := range points {
+		if len(polygon) == 0 || polygon[len(polygon)-1] != point {
+			polygon = append(polygon, point)
+		}
+	}
+	if len(polygon) > 1 && polygon[0] == polygon[len(polygon)-1] {
+		polygon = polygon[:len(polygon)-1]
+	}
+
+	//Keep removing collinear points, as removing one can make its neighbours collinear
+	for removed := true; removed && len(polygon) >= 3; {
+		removed = false
+		for i := 0; i < len(polygon) && len(polygon) >= 3; i++ {
+			prev, next := polygon[(i+len(polygon)-1)%len(polygon)], polygon[(i+1)%len(polygon)]
+			if cross2D(prev, polygon[i], next) == 0 {
+				polygon = append(polygon[:i], polygon[i+1:]...)
+				removed = true
+				i--
+			}
+		}
+	}
+
+	return polygon
+}
+
+//isPolygonEar checks if the corner is convex and no other point of the polygon is inside it
+func isPolygonEar(polygon []Vector2, prev, curr, next Vector2) bool {
+	if cross2D(prev, curr, next) >= 0 {
+		return false
+	}
+
+	for _, point := range polygon {
+		if point == prev || point == curr || point == next {
+			continue
+		}
+		if cross2D(prev, curr, point) <= 0 && cross2D(curr, next, point) <= 0 && cross2D(next, prev, point) <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//polygonSelfIntersects checks if any two edges that are not next to each other touch
+func polygonSelfIntersects(polygon []Vector2) bool {
+	n := len(polygon)
+	for i := 0; i < n; i++ {
+		a1, a2 := polygon[i], polygon[(i+1)%n]
+		for j := i + 2; j < n; j++ {
+			//The last edge is next to the first
+			if i == 0 && j == n-1 {
+				continue
+			}
+			if segmentsTouch(a1, a2, polygon[j], polygon[(j+1)%n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//segmentsTouch checks if the line segments a and b cross or touch
+func segmentsTouch(a1, a2, b1, b2 Vector2) bool {
+	d1, d2 := cross2D(b1, b2, a1), cross2D(b1, b2, a2)
+	d3, d4 := cross2D(a1, a2, b1), cross2D(a1, a2, b2)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(b1, b2, a1)) || (d2 == 0 && onSegment(b1, b2, a2)) ||
+		(d3 == 0 && onSegment(a1, a2, b1)) || (d4 == 0 && onSegment(a1, a2, b2))
+}
+
+//onSegment checks if a point that is collinear with the segment is between its ends
+func onSegment(a, b, point Vector2) bool {
+	return point.X >= float32(math.Min(float64(a.X), float64(b.X))) && point.X <= float32(math.Max(float64(a.X), float64(b.X))) &&
+		point.Y >= float32(math.Min(float64(a.Y), float64(b.Y))) && point.Y <= float32(math.Max(float64(a.Y), float64(b.Y)))
+}
+
+//polygonArea calculates the signed area of the polygon, which is positive when clockwise on the screen
+func polygonArea(polygon []Vector2) float32 {
+	var area float32
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+		area += a.X*b.Y - b.X*a.Y
+	}
+	return area / 2
+}
+
+//cross2D is the cross product of the vectors a to b and b to c. It is 0 when the points are collinear.
+func cross2D(a, b, c Vector2) float32 {
+	return (b.X-a.X)*(c.Y-b.Y) - (b.Y-a.Y)*(c.X-b.X)
+}
@@ -9,7 +9,6 @@ package raylib
 import "C"
 import (
 	"math"
-	"unsafe"
 )
 
 //DrawPixel : Draw a pixel
@@ -55,12 +54,16 @@ func DrawLineBezier(startPos Vector2, endPos Vector2, thick float32, color Color
 	C.DrawLineBezier(cstartPos, cendPos, C.float(thick), ccolor)
 }
 
-//DrawLineStrip : Draw lines sequence
-func DrawLineStrip(points Vector2, numPoints int, color Color) Vector2 {
+//DrawLineStrip : Draw lines sequence, connecting each point to the next.
+// At least 2 points are required to draw anything.
+func DrawLineStrip(points []Vector2, color Color) {
+	if len(points) < 2 {
+		return
+	}
+
 	ccolor := *color.cptr()
-	cpoints := points.cptr()
-	C.DrawLineStrip(cpoints, C.int(int32(numPoints)), ccolor)
-	return newVector2FromPointer(unsafe.Pointer(cpoints))
+	cpoints, count := vector2SliceToC(points)
+	C.DrawLineStrip(cpoints, count, ccolor)
 }
 
 //DrawCircle : Draw a color-filled circle
@@ -216,19 +219,27 @@ func DrawTriangleLines(v1 Vector2, v2 Vector2, v3 Vector2, color Color) {
 }
 
 //DrawTriangleFan : Draw a triangle fan defined by points (first vertex is the center)
-func DrawTriangleFan(points Vector2, numPoints int, color Color) Vector2 {
+// At least 3 points are required to draw anything.
+func DrawTriangleFan(points []Vector2, color Color) {
+	if len(points) < 3 {
+		return
+	}
+
 	ccolor := *color.cptr()
-	cpoints := points.cptr()
-	C.DrawTriangleFan(cpoints, C.int(int32(numPoints)), ccolor)
-	return newVector2FromPointer(unsafe.Pointer(cpoints))
+	cpoints, count := vector2SliceToC(points)
+	C.DrawTriangleFan(cpoints, count, ccolor)
 }
 
 //DrawTriangleStrip : Draw a triangle strip defined by points
-func DrawTriangleStrip(points Vector2, pointsCount int, color Color) Vector2 {
+// At least 3 points are required to draw anything.
+func DrawTriangleStrip(points []Vector2, color Color) {
+	if len(points) < 3 {
+		return
+	}
+
 	ccolor := *color.cptr()
-	cpoints := points.cptr()
-	C.DrawTriangleStrip(cpoints, C.int(int32(pointsCount)), ccolor)
-	return newVector2FromPointer(unsafe.Pointer(cpoints))
+	cpoints, count := vector2SliceToC(points)
+	C.DrawTriangleStrip(cpoints, count, ccolor)
 }
 
 //DrawPoly : Draw a regular polygon (Vector version)
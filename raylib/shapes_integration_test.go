@@ -0,0 +1,117 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import (
+	"math"
+	"testing"
+)
+
+//wavePoints creates a zig-zag line of points across the screen
+func wavePoints(count int) []Vector2 {
+	points := make([]Vector2, count)
+	for i := range points {
+		x := float32(i) / float32(count) * integrationWidth
+		points[i] = NewVector2(x, integrationHeight/2+float32(math.Sin(float64(i)))*16)
+	}
+	return points
+}
+
+func BenchmarkDrawLineStrip(b *testing.B) {
+	requireWindow(b)
+	points := wavePoints(1000)
+
+	onMainThread(func() {
+		BeginDrawing()
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			DrawLineStrip(points, Red)
+		}
+		b.StopTimer()
+		EndDrawing()
+	})
+}
+
+func BenchmarkDrawLineVLoop(b *testing.B) {
+	requireWindow(b)
+	points := wavePoints(1000)
+
+	onMainThread(func() {
+		BeginDrawing()
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			for i := 1; i < len(points); i++ {
+				DrawLineV(points[i-1], points[i], Red)
+			}
+		}
+		b.StopTimer()
+		EndDrawing()
+	})
+}
+
+func TestDrawTriangleFan(t *testing.T) {
+	requireWindow(t)
+
+	//A square around the centre, wound counter-clockwise as raylib expects
+	points := []Vector2{
+		NewVector2(32, 32),
+		NewVector2(16, 16), NewVector2(16, 48), NewVector2(48, 48), NewVector2(48, 16), NewVector2(16, 16),
+	}
+
+	var inside, outside Color
+	onMainThread(func() {
+		BeginDrawing()
+		ClearBackground(Black)
+		DrawTriangleFan(points, Red)
+		EndDrawing()
+
+		inside = ReadFramebufferPixel(24, 40)
+		outside = ReadFramebufferPixel(4, 4)
+	})
+
+	if inside != Red {
+		t.Errorf("pixel inside the fan = %v, want %v", inside, Red)
+	}
+	if outside != Black {
+		t.Errorf("pixel outside the fan = %v, want %v", outside, Black)
+	}
+}
+
+func TestDrawTriangleStrip(t *testing.T) {
+	requireWindow(t)
+
+	points := []Vector2{NewVector2(16, 16), NewVector2(16, 48), NewVector2(48, 16), NewVector2(48, 48)}
+
+	var inside, outside Color
+	onMainThread(func() {
+		BeginDrawing()
+		ClearBackground(Black)
+		DrawTriangleStrip(points, Red)
+		EndDrawing()
+
+		inside = ReadFramebufferPixel(40, 40)
+		outside = ReadFramebufferPixel(60, 60)
+	})
+
+	if inside != Red {
+		t.Errorf("pixel inside the strip = %v, want %v", inside, Red)
+	}
+	if outside != Black {
+		t.Errorf("pixel outside the strip = %v, want %v", outside, Black)
+	}
+}
+
+func TestSetWireframeMode(t *testing.T) {
+	requireWindow(t)
+	t.Cleanup(func() { onMainThread(func() { SetWireframeMode(false) }) })
+
+	onMainThread(func() {
+		for _, enabled := range []bool{true, true, false, true} {
+			SetWireframeMode(enabled)
+			if IsWireframeMode() != enabled {
+				t.Errorf("IsWireframeMode() after SetWireframeMode(%v) = %v", enabled, IsWireframeMode())
+			}
+		}
+	})
+}
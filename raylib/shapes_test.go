@@ -0,0 +1,279 @@
+package raylib
+
+import "testing"
+
+func TestVector2SliceToC(t *testing.T) {
+	points := []Vector2{NewVector2(1, 2), NewVector2(3, 4), NewVector2(5, 6)}
+
+	//C indexes the array with its own Vector2 size, so every point must read back the same
+	cpoints, count := vector2SliceToC(points)
+	if count != 3 {
+		t.Errorf("vector2SliceToC() count = %d, want 3", count)
+	}
+	for i, expected := range points {
+		if actual := vector2AtC(cpoints, i); actual != expected {
+			t.Errorf("point %d read by C = %v, want %v", i, actual, expected)
+		}
+	}
+
+	//The slice is shared rather than copied
+	points[2] = NewVector2(7, 8)
+	if actual := vector2AtC(cpoints, 2); actual != points[2] {
+		t.Errorf("point 2 read by C after changing the slice = %v, want %v", actual, points[2])
+	}
+}
+
+func TestDrawPointsMinimumCount(t *testing.T) {
+	//Too few points must return before converting anything, as nothing can be drawn and nil slices have no first point
+	tests := []struct {
+		name string
+		draw func([]Vector2, Color)
+		min  int
+	}{
+		{"DrawLineStrip", DrawLineStrip, 2},
+		{"DrawTriangleFan", DrawTriangleFan, 3},
+		{"DrawTriangleStrip", DrawTriangleStrip, 3},
+	}
+
+	for _, test := range tests {
+		for count := 0; count < test.min; count++ {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Errorf("%s() with %d points panicked: %v", test.name, count, r)
+					}
+				}()
+
+				var points []Vector2
+				if count > 0 {
+					points = make([]Vector2, count)
+				}
+				test.draw(points, Red)
+			}()
+		}
+	}
+}
+
+func TestCircleGradientVertices(t *testing.T) {
+	center := NewVector2(10, 20)
+	inner := NewColor(255, 255, 255, 255)
+	outer := NewColor(0, 0, 0, 0)
+
+	points, colors := circleGradientVertices(center, 5, 8, inner, outer)
+	if len(points) != 8*3 || len(colors) != len(points) {
+		t.Fatalf("circleGradientVertices() = %d points and %d colours, want %d of each", len(points), len(colors), 8*3)
+	}
+
+	for i, point := range points {
+		distance := point.Distance(center)
+		if i%3 == 0 {
+			if distance != 0 || colors[i] != inner {
+				t.Errorf("vertex %d = %v %v, want the center with the inner colour", i, point, colors[i])
+			}
+		} else if !nearlyEqual(distance, 5) || colors[i] != outer {
+			t.Errorf("vertex %d = %v %v, want on the edge with the outer colour", i, point, colors[i])
+		}
+	}
+
+	if points, _ := circleGradientVertices(center, 5, 1, inner, outer); len(points) != 3*3 {
+		t.Errorf("circleGradientVertices() with 1 segment = %d points, want %d", len(points), 3*3)
+	}
+}
+
+func TestRadialGradientVertices(t *testing.T) {
+	rec := NewRectangle(0, 0, 60, 80)
+	inner := NewColor(255, 255, 255, 255)
+	outer := NewColor(0, 0, 0, 255)
+
+	points, colors := radialGradientVertices(rec, inner, outer)
+	if len(points) != 8*8*6 || len(colors) != len(points) {
+		t.Fatalf("radialGradientVertices() = %d points and %d colours, want %d of each", len(points), len(colors), 8*8*6)
+	}
+
+	//The corners are at the radius, while the center is the inner colour
+	expected := map[Vector2]Color{
+		NewVector2(0, 0):   outer,
+		NewVector2(60, 0):  outer,
+		NewVector2(0, 80):  outer,
+		NewVector2(60, 80): outer,
+		NewVector2(30, 40): inner,
+	}
+
+	found := make(map[Vector2]bool)
+	for i, point := range points {
+		if point.X < rec.X || point.Y < rec.Y || point.X > rec.X+rec.Width || point.Y > rec.Y+rec.Height {
+			t.Errorf("vertex %d = %v, want inside %v", i, point, rec)
+		}
+		if color, ok := expected[point]; ok {
+			found[point] = true
+			if colors[i] != color {
+				t.Errorf("vertex %v colour = %v, want %v", point, colors[i], color)
+			}
+		}
+	}
+	for point := range expected {
+		if !found[point] {
+			t.Errorf("radialGradientVertices() has no vertex at %v", point)
+		}
+	}
+}
+
+func TestRadialGradientColor(t *testing.T) {
+	inner := NewColor(0, 0, 0, 255)
+	outer := NewColor(200, 100, 50, 255)
+	center := NewVector2(0, 0)
+
+	tests := []struct {
+		point    Vector2
+		expected Color
+	}{
+		{NewVector2(0, 0), inner},
+		{NewVector2(5, 0), NewColor(100, 50, 25, 255)},
+		{NewVector2(0, 10), outer},
+		{NewVector2(20, 0), outer},
+	}
+
+	for _, test := range tests {
+		if actual := radialGradientColor(test.point, center, 10, inner, outer); actual != test.expected {
+			t.Errorf("radialGradientColor(%v) = %v, want %v", test.point, actual, test.expected)
+		}
+	}
+
+	if actual := radialGradientColor(center, center, 0, inner, outer); actual != outer {
+		t.Errorf("radialGradientColor() with no radius = %v, want %v", actual, outer)
+	}
+}
+
+func TestRoundedRectangleOutline(t *testing.T) {
+	rec := NewRectangle(10, 20, 100, 40)
+
+	tests := []struct {
+		name     string
+		radii    [4]float32
+		count    int
+		expected []Vector2
+	}{
+		{"square corners", [4]float32{0, 0, 0, 0}, 4, []Vector2{NewVector2(10, 20), NewVector2(10, 60), NewVector2(110, 60), NewVector2(110, 20)}},
+		{"one rounded corner", [4]float32{10, 0, 0, 0}, 4 + 3, []Vector2{NewVector2(20, 20), NewVector2(10, 30), NewVector2(10, 60)}},
+		{"clamped to half the height", [4]float32{50, 50, 50, 50}, 4 * 4, []Vector2{NewVector2(30, 20), NewVector2(10, 40), NewVector2(90, 60), NewVector2(110, 40)}},
+		{"negative radius", [4]float32{-5, 0, 0, 0}, 4, []Vector2{NewVector2(10, 20)}},
+	}
+
+	for _, test := range tests {
+		outline := roundedRectangleOutline(rec, test.radii, 3)
+		if len(outline) != test.count {
+			t.Errorf("%s: roundedRectangleOutline() = %d points, want %d", test.name, len(outline), test.count)
+		}
+
+		for _, point := range outline {
+			if point.X < rec.X-testEpsilon || point.Y < rec.Y-testEpsilon || point.X > rec.X+rec.Width+testEpsilon || point.Y > rec.Y+rec.Height+testEpsilon {
+				t.Errorf("%s: point %v is outside %v", test.name, point, rec)
+			}
+		}
+
+		for _, expected := range test.expected {
+			found := false
+			for _, point := range outline {
+				if vector2NearlyEqual(point, expected) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("%s: roundedRectangleOutline() = %v, want it to contain %v", test.name, outline, expected)
+			}
+		}
+
+		//The fan from the center needs the outline counter-clockwise on the screen, which is a negative area as Y points down
+		if area := polygonArea(outline); area >= 0 {
+			t.Errorf("%s: outline area = %v, want counter-clockwise on the screen", test.name, area)
+		}
+	}
+
+	if outline := roundedRectangleOutline(NewRectangle(0, 0, 0, 10), [4]float32{}, 3); outline != nil {
+		t.Errorf("roundedRectangleOutline() with no width = %v, want nil", outline)
+	}
+}
+
+func TestRoundedCornerSegments(t *testing.T) {
+	if count := roundedCornerSegments(100, 5); count != 5 {
+		t.Errorf("roundedCornerSegments() with segments = %d, want 5", count)
+	}
+	if count := roundedCornerSegments(0.25, 0); count != 1 {
+		t.Errorf("roundedCornerSegments() of a tiny corner = %d, want 1", count)
+	}
+	if small, large := roundedCornerSegments(4, 0), roundedCornerSegments(64, 0); small >= large {
+		t.Errorf("roundedCornerSegments() = %d for radius 4 and %d for radius 64, want more for the larger corner", small, large)
+	}
+}
+
+func TestTriangulatePolygon(t *testing.T) {
+	square := []Vector2{NewVector2(0, 0), NewVector2(10, 0), NewVector2(10, 10), NewVector2(0, 10)}
+	lShape := []Vector2{
+		NewVector2(0, 0), NewVector2(10, 0), NewVector2(10, 5),
+		NewVector2(5, 5), NewVector2(5, 10), NewVector2(0, 10),
+	}
+	reversed := make([]Vector2, len(lShape))
+	for i, point := range lShape {
+		reversed[len(lShape)-1-i] = point
+	}
+
+	tests := []struct {
+		name      string
+		points    []Vector2
+		triangles int
+		area      float32
+	}{
+		{"square", square, 2, 100},
+		{"concave L", lShape, 4, 75},
+		{"concave L reversed", reversed, 4, 75},
+		{"collinear point", []Vector2{NewVector2(0, 0), NewVector2(5, 0), NewVector2(10, 0), NewVector2(10, 10), NewVector2(0, 10)}, 2, 100},
+		{"too few points", square[:2], 0, 0},
+		{"self intersecting", []Vector2{NewVector2(0, 0), NewVector2(10, 10), NewVector2(10, 0), NewVector2(0, 10)}, 0, 0},
+	}
+
+	for _, test := range tests {
+		triangles := triangulatePolygon(test.points)
+		if len(triangles) != test.triangles*3 {
+			t.Errorf("%s: triangulatePolygon() = %d triangles, want %d", test.name, len(triangles)/3, test.triangles)
+			continue
+		}
+
+		//The triangles should cover the polygon exactly, and all be counter-clockwise on the screen
+		var area float32
+		for i := 0; i < len(triangles); i += 3 {
+			triangleArea := polygonArea(triangles[i : i+3])
+			if triangleArea >= 0 {
+				t.Errorf("%s: triangle %v is not counter-clockwise on the screen", test.name, triangles[i:i+3])
+			}
+			area -= triangleArea
+		}
+		if !nearlyEqual(area, test.area) {
+			t.Errorf("%s: triangles cover an area of %v, want %v", test.name, area, test.area)
+		}
+	}
+}
+
+func TestProgressFillWidth(t *testing.T) {
+	tests := []struct {
+		name                   string
+		value, min, max, width float32
+		expected               float32
+	}{
+		{"empty", 0, 0, 10, 200, 0},
+		{"half", 5, 0, 10, 200, 100},
+		{"full", 10, 0, 10, 200, 200},
+		{"offset range", 15, 10, 30, 200, 50},
+		{"below", -5, 0, 10, 200, 0},
+		{"above", 50, 0, 10, 200, 200},
+		{"swapped range", 2.5, 10, 0, 200, 50},
+		{"equal range below", 4, 5, 5, 200, 0},
+		{"equal range reached", 5, 5, 5, 200, 200},
+	}
+
+	for _, test := range tests {
+		if actual := progressFillWidth(test.width, test.value, test.min, test.max); !nearlyEqual(actual, test.expected) {
+			t.Errorf("%s: progressFillWidth() = %v, want %v", test.name, actual, test.expected)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package raylib
+
+//MinSoundPitch is the lowest pitch a sound can be set to, as raylib cannot play a sound without any pitch
+const MinSoundPitch = 0.01
+
+//SoundManager watches sounds and invokes callbacks when they finish playing, useful for chaining sound effects.
+// Call Update once per frame to check the sounds.
+type SoundManager struct {
+	sounds []*watchedSound
+
+	isPlaying func(sound *Sound) bool
+}
+
+type watchedSound struct {
+	sound      *Sound
+	callbacks  []func()
+	wasPlaying bool
+	paused     bool
+}
+
+//NewSoundManager creates a new manager that is not watching any sounds
+func NewSoundManager() *SoundManager {
+	return &SoundManager{
+		sounds:    make([]*watchedSound, 0),
+		isPlaying: IsSoundPlaying,
+	}
+}
+
+//OnFinished registers a callback that is invoked every time the sound stops playing.
+// It is invoked once per play, and is not invoked when the sound is paused through the manager.
+func (sm *SoundManager) OnFinished(sound *Sound, fn func()) {
+	watched := sm.watch(sound)
+	watched.callbacks = append(watched.callbacks, fn)
+}
+
+//Remove stops watching the sound and removes its callbacks
+func (sm *SoundManager) Remove(sound *Sound) {
+	for i, watched := range sm.sounds {
+		if watched.sound == sound {
+			sm.sounds = append(sm.sounds[:i], sm.sounds[i+1:]...)
+			return
+		}
+	}
+}
+
+//Play plays the sound. Sounds played this way will always invoke their callbacks, even if they finish before the next Update.
+func (sm *SoundManager) Play(sound *Sound) {
+	watched := sm.watch(sound)
+	watched.wasPlaying = true
+	watched.paused = false
+	sound.Play()
+}
+
+//Pause pauses the sound without invoking its callbacks
+func (sm *SoundManager) Pause(sound *Sound) {
+	sm.watch(sound).paused = true
+	sound.Pause()
+}
+
+//Resume continues playing a paused sound
+func (sm *SoundManager) Resume(sound *Sound) {
+	sm.watch(sound).paused = false
+	sound.Resume()
+}
+
+//Update checks every watched sound, invoking the callbacks of sounds that were playing but have now stopped.
+func (sm *SoundManager) Update() {
+	for _, watched := range sm.sounds {
+		playing := sm.isPlaying(watched.sound)
+		finished := watched.wasPlaying && !playing && !watched.paused
+		watched.wasPlaying = playing
+
+		if finished {
+			for _, fn := range watched.callbacks {
+				fn()
+			}
+		}
+	}
+}
+
+//watch gets the watched entry for the sound, adding it if it is not being watched yet
+func (sm *SoundManager) watch(sound *Sound) *watchedSound {
+	for _, watched := range sm.sounds {
+		if watched.sound == sound {
+			return watched
+		}
+	}
+
+	watched := &watchedSound{sound: sound, wasPlaying: sm.isPlaying(sound)}
+	sm.sounds = append(sm.sounds, watched)
+	return watched
+}
@@ -0,0 +1,77 @@
+package raylib
+
+import "testing"
+
+//fakeSoundManager creates a manager that reads whether sounds are playing from the map instead of the audio device
+func fakeSoundManager(playing map[*Sound]bool) *SoundManager {
+	sm := NewSoundManager()
+	sm.isPlaying = func(sound *Sound) bool { return playing[sound] }
+	return sm
+}
+
+func TestSoundManagerFinished(t *testing.T) {
+	a, b := &Sound{}, &Sound{}
+	playing := map[*Sound]bool{a: true}
+	sm := fakeSoundManager(playing)
+
+	finished := make(map[*Sound]int)
+	sm.OnFinished(a, func() { finished[a]++ })
+	sm.OnFinished(a, func() { finished[a]++ })
+	sm.OnFinished(b, func() { finished[b]++ })
+
+	steps := []struct {
+		name     string
+		a, b     bool
+		finished map[*Sound]int
+	}{
+		{"still playing", true, false, map[*Sound]int{}},
+		{"a stops", false, false, map[*Sound]int{a: 2}},
+		{"stays stopped", false, false, map[*Sound]int{a: 2}},
+		{"both start", true, true, map[*Sound]int{a: 2}},
+		{"both stop", false, false, map[*Sound]int{a: 4, b: 1}},
+	}
+
+	for _, step := range steps {
+		playing[a], playing[b] = step.a, step.b
+		sm.Update()
+		if finished[a] != step.finished[a] || finished[b] != step.finished[b] {
+			t.Errorf("%s: callbacks invoked %d times for a and %d for b, want %d and %d", step.name, finished[a], finished[b], step.finished[a], step.finished[b])
+		}
+	}
+
+	sm.Remove(a)
+	playing[a] = true
+	sm.Update()
+	playing[a] = false
+	sm.Update()
+	if finished[a] != 4 {
+		t.Errorf("callbacks invoked after Remove(), %d times, want still 4", finished[a])
+	}
+}
+
+func TestSoundManagerPause(t *testing.T) {
+	sound := &Sound{}
+	playing := map[*Sound]bool{sound: true}
+	sm := fakeSoundManager(playing)
+
+	finished := 0
+	sm.OnFinished(sound, func() { finished++ })
+
+	//A paused sound is not playing, but has not finished either.
+	// The flags are set directly as Pause and Resume need a loaded sound, and raylib exits on the error.
+	sm.watch(sound).paused = true
+	playing[sound] = false
+	sm.Update()
+	if finished != 0 {
+		t.Errorf("callbacks invoked %d times after Pause(), want 0", finished)
+	}
+
+	sm.watch(sound).paused = false
+	playing[sound] = true
+	sm.Update()
+	playing[sound] = false
+	sm.Update()
+	if finished != 1 {
+		t.Errorf("callbacks invoked %d times after resuming and stopping, want 1", finished)
+	}
+}
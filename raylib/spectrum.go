@@ -0,0 +1,176 @@
+package raylib
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+//SpectrumAnalyzer computes the frequency spectrum of audio samples, useful for music visualizers.
+// Samples are written into the analyzer as they are played, then Update performs a FFT over the most recent samples.
+type SpectrumAnalyzer struct {
+	//SampleRate is the sample rate of the samples written to the analyzer
+	SampleRate int
+
+	size       int
+	buffer     []float32
+	window     []float64
+	spectrum   []complex128
+	magnitudes []float32
+	bands      []float32
+	bandEdges  []int
+}
+
+//NewSpectrumAnalyzer creates a new analyzer. The size is the amount of samples used by the FFT and will be rounded up to a power of two.
+// The spectrum is grouped into the given number of logarithmically spaced bands.
+func NewSpectrumAnalyzer(size, sampleRate, bands int) *SpectrumAnalyzer {
+	if size < 4 {
+		size = 4
+	}
+
+	//Round up to a power of two
+	pot := 1
+	for pot < size {
+		pot <<= 1
+	}
+	size = pot
+
+	if bands < 1 {
+		bands = 1
+	}
+
+	//Hann window to reduce spectral leakage
+	window := make([]float64, size)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size-1))
+	}
+
+	sa := &SpectrumAnalyzer{
+		SampleRate: sampleRate,
+		size:       size,
+		buffer:     make([]float32, size),
+		window:     window,
+		spectrum:   make([]complex128, size),
+		magnitudes: make([]float32, size/2),
+		bands:      make([]float32, bands),
+	}
+	sa.bandEdges = sa.calculateBandEdges(bands)
+	return sa
+}
+
+//Size is the amount of samples used by the FFT
+func (sa *SpectrumAnalyzer) Size() int { return sa.size }
+
+//Write pushes new mono samples into the analyzer, discarding the oldest samples.
+func (sa *SpectrumAnalyzer) Write(samples []float32) {
+	if len(samples) >= sa.size {
+		copy(sa.buffer, samples[len(samples)-sa.size:])
+		return
+	}
+
+	copy(sa.buffer, sa.buffer[len(samples):])
+	copy(sa.buffer[sa.size-len(samples):], samples)
+}
+
+//Update performs the FFT over the most recent samples and recalculates the magnitudes and bands.
+func (sa *SpectrumAnalyzer) Update() {
+	for i, s := range sa.buffer {
+		sa.spectrum[i] = complex(float64(s)*sa.window[i], 0)
+	}
+
+	fft(sa.spectrum)
+
+	//Only the first half is useful as the input is real
+	scale := 2 / float64(sa.size)
+	for i := range sa.magnitudes {
+		sa.magnitudes[i] = float32(cmplx.Abs(sa.spectrum[i]) * scale)
+	}
+
+	for b := range sa.bands {
+		start, end := sa.bandEdges[b], sa.bandEdges[b+1]
+		sum := float32(0)
+		for _, m := range sa.magnitudes[start:end] {
+			sum += m
+		}
+		sa.bands[b] = sum / float32(end-start)
+	}
+}
+
+//Magnitudes gets the magnitude of each frequency bin. There are Size / 2 bins.
+func (sa *SpectrumAnalyzer) Magnitudes() []float32 { return sa.magnitudes }
+
+//Bands gets the average magnitude of each band
+func (sa *SpectrumAnalyzer) Bands() []float32 { return sa.bands }
+
+//BinFrequency gets the frequency in hertz that a bin represents
+func (sa *SpectrumAnalyzer) BinFrequency(bin int) float32 {
+	return float32(bin) * float32(sa.SampleRate) / float32(sa.size)
+}
+
+//BandFrequency gets the frequency range in hertz that a band covers
+func (sa *SpectrumAnalyzer) BandFrequency(band int) (low, high float32) {
+	return sa.BinFrequency(sa.bandEdges[band]), sa.BinFrequency(sa.bandEdges[band+1])
+}
+
+//calculateBandEdges splits the bins into logarithmically spaced bands, making sure every band has at least one bin.
+func (sa *SpectrumAnalyzer) calculateBandEdges(bands int) []int {
+	bins := sa.size / 2
+	if bands > bins-1 {
+		bands = bins - 1
+		sa.bands = sa.bands[:bands]
+	}
+
+	//Skip the DC bin
+	edges := make([]int, bands+1)
+	edges[0] = 1
+	ratio := math.Pow(float64(bins), 1/float64(bands))
+	for i := 1; i <= bands; i++ {
+		edge := int(math.Round(math.Pow(ratio, float64(i))))
+		if edge <= edges[i-1] {
+			edge = edges[i-1] + 1
+		}
+
+		//Leave enough room for the remaining bands
+		if remaining := bands - i; edge > bins-remaining {
+			edge = bins - remaining
+		}
+
+		edges[i] = edge
+	}
+
+	edges[bands] = bins
+	return edges
+}
+
+//fft performs an in-place iterative radix-2 Cooley-Tukey FFT. The length must be a power of two.
+func fft(values []complex128) {
+	n := len(values)
+
+	//Bit reversal permutation
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+
+		if i < j {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+
+	//Butterflies
+	for length := 2; length <= n; length <<= 1 {
+		angle := -2 * math.Pi / float64(length)
+		step := complex(math.Cos(angle), math.Sin(angle))
+		for start := 0; start < n; start += length {
+			w := complex(1, 0)
+			for k := 0; k < length/2; k++ {
+				even := values[start+k]
+				odd := values[start+k+length/2] * w
+				values[start+k] = even + odd
+				values[start+k+length/2] = even - odd
+				w *= step
+			}
+		}
+	}
+}
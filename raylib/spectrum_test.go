@@ -0,0 +1,65 @@
+package raylib
+
+import (
+	"math"
+	"testing"
+)
+
+//sineSamples generates a pure sine wave at the frequency
+func sineSamples(count, sampleRate int, frequency, amplitude float64) []float32 {
+	samples := make([]float32, count)
+	for i := range samples {
+		samples[i] = float32(amplitude * math.Sin(2*math.Pi*frequency*float64(i)/float64(sampleRate)))
+	}
+	return samples
+}
+
+func TestSpectrumAnalyzerSinePeak(t *testing.T) {
+	analyzer := NewSpectrumAnalyzer(1024, 44100, 8)
+
+	//A frequency exactly on bin 64
+	frequency := float64(analyzer.BinFrequency(64))
+	analyzer.Write(sineSamples(analyzer.Size(), 44100, frequency, 1))
+	analyzer.Update()
+
+	magnitudes := analyzer.Magnitudes()
+	if len(magnitudes) != 512 {
+		t.Fatalf("Magnitudes() has %d bins, want 512", len(magnitudes))
+	}
+
+	peak := 0
+	for i, magnitude := range magnitudes {
+		if magnitude > magnitudes[peak] {
+			peak = i
+		}
+	}
+	if peak != 64 {
+		t.Errorf("peak is in bin %d, want 64", peak)
+	}
+
+	//The Hann window roughly halves the amplitude of the peak bin
+	if math.Abs(float64(magnitudes[64])-0.5) > 0.01 {
+		t.Errorf("peak magnitude = %v, want about 0.5", magnitudes[64])
+	}
+
+	//The band covering the frequency is the loudest
+	loudest := 0
+	bands := analyzer.Bands()
+	for i, band := range bands {
+		if band > bands[loudest] {
+			loudest = i
+		}
+	}
+	if low, high := analyzer.BandFrequency(loudest); float64(low) > frequency || float64(high) <= frequency {
+		t.Errorf("loudest band %d covers %v to %v Hz, want it to contain %v Hz", loudest, low, high, frequency)
+	}
+}
+
+func TestSpectrumAnalyzerSize(t *testing.T) {
+	if size := NewSpectrumAnalyzer(1000, 44100, 8).Size(); size != 1024 {
+		t.Errorf("Size() = %d, want 1000 rounded up to 1024", size)
+	}
+	if bands := len(NewSpectrumAnalyzer(8, 44100, 100).Bands()); bands != 3 {
+		t.Errorf("Bands() has %d bands, want 3 for the 3 bins above DC", bands)
+	}
+}
@@ -0,0 +1,315 @@
+package raylib
+
+import "sort"
+
+//SpriteAnimation plays a sequence of frames from a sprite sheet texture.
+type SpriteAnimation struct {
+	//Texture is the sprite sheet the frames are taken from
+	Texture Texture2D
+	//Frames are the source rectangles of each frame within the texture
+	Frames []Rectangle
+	//Durations is the time in seconds each frame is shown for. Frames without a duration will hold.
+	Durations []float32
+	//Speed is a multiplier applied to the time passed into Update
+	Speed float32
+	//Loop will restart the animation once the last frame has finished
+	Loop bool
+	//PingPong will play the animation backwards once it reaches the end, instead of restarting it
+	PingPong bool
+
+	currentFrame int
+	direction    int
+	elapsed      float32
+	started      bool
+	finished     bool
+	events       map[int][]func()
+}
+
+//NewSpriteAnimation creates a new looping animation where every frame has the same duration
+func NewSpriteAnimation(texture Texture2D, frames []Rectangle, frameDuration float32) *SpriteAnimation {
+	durations := make([]float32, len(frames))
+	for i := range durations {
+		durations[i] = frameDuration
+	}
+
+	return &SpriteAnimation{
+		Texture:   texture,
+		Frames:    frames,
+		Durations: durations,
+		Speed:     1,
+		Loop:      true,
+		direction: 1,
+	}
+}
+
+//OnFrame registers a callback that is invoked every time the frame becomes active.
+// This is only invoked once per entry into the frame, not every update or draw.
+func (anim *SpriteAnimation) OnFrame(frame int, fn func()) {
+	if anim.events == nil {
+		anim.events = make(map[int][]func())
+	}
+	anim.events[frame] = append(anim.events[frame], fn)
+}
+
+//Update advances the animation by the delta time, firing any frame events that become active.
+func (anim *SpriteAnimation) Update(dt float32) {
+	if len(anim.Frames) == 0 || anim.finished {
+		return
+	}
+
+	//The first frame becomes active as soon as we start playing
+	if !anim.started {
+		anim.started = true
+		anim.fireEvents()
+	}
+
+	anim.elapsed += dt * anim.Speed
+	for !anim.finished {
+		duration := anim.frameDuration(anim.currentFrame)
+		if duration <= 0 || anim.elapsed < duration {
+			break
+		}
+
+		anim.elapsed -= duration
+		anim.advance()
+	}
+}
+
+//advance moves onto the next frame, taking into account looping and ping-pong playback
+func (anim *SpriteAnimation) advance() {
+	if anim.direction == 0 {
+		anim.direction = 1
+	}
+
+	next := anim.currentFrame + anim.direction
+	if next < 0 || next >= len(anim.Frames) {
+		switch {
+		case anim.PingPong && len(anim.Frames) > 1:
+			anim.direction = -anim.direction
+			next = anim.currentFrame + anim.direction
+
+			//Going backwards and reached the start, so we have done a full cycle
+			if anim.direction > 0 && !anim.Loop {
+				anim.finish()
+				return
+			}
+
+		case anim.Loop:
+			next = 0
+
+		default:
+			anim.finish()
+			return
+		}
+	}
+
+	anim.currentFrame = next
+	anim.fireEvents()
+}
+
+//finish stops the animation on the current frame
+func (anim *SpriteAnimation) finish() {
+	anim.finished = true
+	anim.elapsed = 0
+}
+
+//fireEvents invokes all the callbacks for the current frame
+func (anim *SpriteAnimation) fireEvents() {
+	for _, fn := range anim.events[anim.currentFrame] {
+		fn()
+	}
+}
+
+//frameDuration gets the duration of a frame, or 0 if it has none.
+func (anim *SpriteAnimation) frameDuration(frame int) float32 {
+	if frame < 0 || frame >= len(anim.Durations) {
+		return 0
+	}
+	return anim.Durations[frame]
+}
+
+//CurrentFrame returns the current frame index
+func (anim *SpriteAnimation) CurrentFrame() int { return anim.currentFrame }
+
+//SetFrame jumps to a specific frame, firing its events.
+func (anim *SpriteAnimation) SetFrame(frame int) {
+	if frame < 0 || frame >= len(anim.Frames) {
+		return
+	}
+	anim.currentFrame = frame
+	anim.elapsed = 0
+	anim.started = true
+	anim.fireEvents()
+}
+
+//IsFinished returns true if a non-looping animation has played all of its frames
+func (anim *SpriteAnimation) IsFinished() bool { return anim.finished }
+
+//Reset rewinds the animation back to the first frame
+func (anim *SpriteAnimation) Reset() {
+	anim.currentFrame = 0
+	anim.direction = 1
+	anim.elapsed = 0
+	anim.started = false
+	anim.finished = false
+}
+
+//SourceRec gets the rectangle of the current frame within the texture
+func (anim *SpriteAnimation) SourceRec() Rectangle {
+	if len(anim.Frames) == 0 {
+		return Rectangle{}
+	}
+	return anim.Frames[anim.currentFrame]
+}
+
+//Draw draws the current frame at the position
+func (anim *SpriteAnimation) Draw(position Vector2, tint Color) {
+	DrawTextureRec(anim.Texture, anim.SourceRec(), position, tint)
+}
+
+//DrawPro draws the current frame into the destination rectangle with rotation
+func (anim *SpriteAnimation) DrawPro(destRec Rectangle, origin Vector2, rotation float32, tint Color) {
+	DrawTexturePro(anim.Texture, anim.SourceRec(), destRec, origin, rotation, tint)
+}
+
+//DrawSpriteFacing draws part of a texture at the position, flipped horizontally when facing is negative.
+// This is useful for characters that only have sprites facing right. A facing of 0 is treated the same as positive.
+func DrawSpriteFacing(t Texture2D, source Rectangle, position Vector2, facing int, scale float32, tint Color) {
+	destRec := NewRectangle(position.X, position.Y, source.Width*scale, source.Height*scale)
+	DrawTexturePro(t, facingSourceRec(source, facing), destRec, NewVector2Zero(), 0, tint)
+}
+
+//facingSourceRec flips the width of the source rectangle if the facing is negative.
+func facingSourceRec(source Rectangle, facing int) Rectangle {
+	if facing < 0 {
+		source.Width = -source.Width
+	}
+	return source
+}
+
+//SpriteSheet is a texture split into a grid of equally sized frames. Frames are numbered left to right, then top to bottom.
+type SpriteSheet struct {
+	//Texture is the sheet the frames are taken from
+	Texture Texture2D
+	//FrameWidth is the width of each frame in pixels
+	FrameWidth int
+	//FrameHeight is the height of each frame in pixels
+	FrameHeight int
+}
+
+//NewSpriteSheet creates a sprite sheet for the texture with frames of the given size.
+// Partial frames on the right and bottom edges of the texture are ignored.
+func NewSpriteSheet(texture Texture2D, frameWidth, frameHeight int) *SpriteSheet {
+	return &SpriteSheet{Texture: texture, FrameWidth: frameWidth, FrameHeight: frameHeight}
+}
+
+//Columns is the number of frames in each row of the sheet
+func (sheet *SpriteSheet) Columns() int {
+	if sheet.FrameWidth <= 0 {
+		return 0
+	}
+	return int(sheet.Texture.Width) / sheet.FrameWidth
+}
+
+//Rows is the number of rows of frames in the sheet
+func (sheet *SpriteSheet) Rows() int {
+	if sheet.FrameHeight <= 0 {
+		return 0
+	}
+	return int(sheet.Texture.Height) / sheet.FrameHeight
+}
+
+//FrameCount is the total number of frames in the sheet
+func (sheet *SpriteSheet) FrameCount() int { return sheet.Columns() * sheet.Rows() }
+
+//FrameRect gets the source rectangle of the frame within the texture. Frames outside of the sheet return an empty rectangle.
+func (sheet *SpriteSheet) FrameRect(index int) Rectangle {
+	if index < 0 || index >= sheet.FrameCount() {
+		return Rectangle{}
+	}
+
+	columns := sheet.Columns()
+	x, y := index%columns, index/columns
+	return NewRectangle(float32(x*sheet.FrameWidth), float32(y*sheet.FrameHeight), float32(sheet.FrameWidth), float32(sheet.FrameHeight))
+}
+
+//DrawFrame draws the frame at the position. Frames outside of the sheet are not drawn.
+func (sheet *SpriteSheet) DrawFrame(index int, position Vector2, tint Color) {
+	if index < 0 || index >= sheet.FrameCount() {
+		return
+	}
+	DrawTextureRec(sheet.Texture, sheet.FrameRect(index), position, tint)
+}
+
+//Animation creates an animation that plays count frames of the sheet, starting at the first frame.
+// Frames past the end of the sheet are left out.
+func (sheet *SpriteSheet) Animation(first, count int, frameDuration float32) *SpriteAnimation {
+	frames := make([]Rectangle, 0)
+	for i := first; i < first+count && i < sheet.FrameCount(); i++ {
+		if i >= 0 {
+			frames = append(frames, sheet.FrameRect(i))
+		}
+	}
+	return NewSpriteAnimation(sheet.Texture, frames, frameDuration)
+}
+
+//SpriteBatch collects sprites to be drawn together, grouping them by texture to reduce texture switches.
+// Sprites that share a texture keep the order they were added in, but sprites of different textures may be reordered.
+type SpriteBatch struct {
+	items []spriteBatchItem
+}
+
+type spriteBatchItem struct {
+	texture  Texture2D
+	source   Rectangle
+	dest     Rectangle
+	origin   Vector2
+	rotation float32
+	tint     Color
+}
+
+//NewSpriteBatch creates a new empty batch
+func NewSpriteBatch() *SpriteBatch {
+	return &SpriteBatch{items: make([]spriteBatchItem, 0)}
+}
+
+//Add queues the current frame of the animation to be drawn into the destination rectangle
+func (batch *SpriteBatch) Add(anim *SpriteAnimation, destRec Rectangle, origin Vector2, rotation float32, tint Color) {
+	batch.AddTexture(anim.Texture, anim.SourceRec(), destRec, origin, rotation, tint)
+}
+
+//AddTexture queues part of a texture to be drawn into the destination rectangle
+func (batch *SpriteBatch) AddTexture(texture Texture2D, sourceRec, destRec Rectangle, origin Vector2, rotation float32, tint Color) {
+	batch.items = append(batch.items, spriteBatchItem{texture, sourceRec, destRec, origin, rotation, tint})
+}
+
+//Len is the number of queued sprites
+func (batch *SpriteBatch) Len() int { return len(batch.items) }
+
+//Flush draws all the queued sprites grouped by their texture, then clears the batch.
+func (batch *SpriteBatch) Flush() {
+	for _, group := range batch.groups() {
+		for _, item := range group {
+			DrawTexturePro(item.texture, item.source, item.dest, item.origin, item.rotation, item.tint)
+		}
+	}
+	batch.items = batch.items[:0]
+}
+
+//groups sorts the queued sprites by texture and splits them into a group for each texture.
+func (batch *SpriteBatch) groups() [][]spriteBatchItem {
+	sort.SliceStable(batch.items, func(i, j int) bool {
+		return batch.items[i].texture.Id < batch.items[j].texture.Id
+	})
+
+	groups := make([][]spriteBatchItem, 0)
+	start := 0
+	for i := 1; i <= len(batch.items); i++ {
+		if i == len(batch.items) || batch.items[i].texture.Id != batch.items[start].texture.Id {
+			groups = append(groups, batch.items[start:i])
+			start = i
+		}
+	}
+
+	return groups
+}
@@ -0,0 +1,185 @@
+package raylib
+
+import "testing"
+
+//testFrames creates count frames of a 16x16 horizontal strip
+func testFrames(count int) []Rectangle {
+	frames := make([]Rectangle, count)
+	for i := range frames {
+		frames[i] = NewRectangle(float32(i*16), 0, 16, 16)
+	}
+	return frames
+}
+
+func TestSpriteAnimationOnFrame(t *testing.T) {
+	anim := NewSpriteAnimation(Texture2D{}, testFrames(3), 0.5)
+
+	entries := make([]int, 3)
+	for frame := range entries {
+		frame := frame
+		anim.OnFrame(frame, func() { entries[frame]++ })
+	}
+
+	assertEntries := func(step string, expected ...int) {
+		t.Helper()
+		for frame := range expected {
+			if entries[frame] != expected[frame] {
+				t.Fatalf("%s: frame events fired %v, want %v", step, entries, expected)
+			}
+		}
+	}
+
+	//The first frame is entered when the animation starts, and staying on it does not fire again
+	anim.Update(0.25)
+	assertEntries("start", 1, 0, 0)
+	anim.Update(0.125)
+	assertEntries("same frame", 1, 0, 0)
+
+	anim.Update(0.25)
+	assertEntries("second frame", 1, 1, 0)
+	anim.Update(0.25)
+	assertEntries("still second frame", 1, 1, 0)
+
+	//Looping enters the first frame again
+	anim.Update(1)
+	assertEntries("looped", 2, 1, 1)
+
+	anim.SetFrame(2)
+	assertEntries("set frame", 2, 1, 2)
+}
+
+func TestSpriteAnimationOnFrameSkipped(t *testing.T) {
+	anim := NewSpriteAnimation(Texture2D{}, testFrames(4), 0.25)
+	anim.Loop = false
+
+	entries := 0
+	anim.OnFrame(2, func() { entries++ })
+
+	//A large update passes through the frame, so it is still entered once
+	anim.Update(10)
+	if entries != 1 {
+		t.Errorf("frame event fired %d times, want 1", entries)
+	}
+	if !anim.IsFinished() || anim.CurrentFrame() != 3 {
+		t.Errorf("animation finished = %v on frame %d, want finished on frame 3", anim.IsFinished(), anim.CurrentFrame())
+	}
+}
+
+func TestSpriteBatchGroups(t *testing.T) {
+	first, second := Texture2D{Id: 2}, Texture2D{Id: 1}
+
+	batch := NewSpriteBatch()
+	for i := 0; i < 6; i++ {
+		texture := first
+		if i%2 == 1 {
+			texture = second
+		}
+		batch.AddTexture(texture, NewRectangle(float32(i), 0, 1, 1), Rectangle{}, Vector2{}, 0, White)
+	}
+	if batch.Len() != 6 {
+		t.Fatalf("batch has %d sprites, want 6", batch.Len())
+	}
+
+	groups := batch.groups()
+	if len(groups) != 2 {
+		t.Fatalf("batch has %d groups, want 2", len(groups))
+	}
+
+	//Each group has one texture, and keeps the order the sprites were added in
+	expected := map[uint32][]float32{1: {1, 3, 5}, 2: {0, 2, 4}}
+	for _, group := range groups {
+		order := expected[group[0].texture.Id]
+		if len(group) != len(order) {
+			t.Fatalf("group of texture %d has %d sprites, want %d", group[0].texture.Id, len(group), len(order))
+		}
+		for i, item := range group {
+			if item.texture.Id != group[0].texture.Id || item.source.X != order[i] {
+				t.Errorf("group of texture %d has sprite %v of texture %d at %d, want sprite %v", group[0].texture.Id, item.source.X, item.texture.Id, i, order[i])
+			}
+		}
+	}
+}
+
+func TestSpriteBatchGroupsEmpty(t *testing.T) {
+	if groups := NewSpriteBatch().groups(); len(groups) != 0 {
+		t.Errorf("empty batch has %d groups, want 0", len(groups))
+	}
+}
+
+func BenchmarkSpriteBatchGroups(b *testing.B) {
+	textures := []Texture2D{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}}
+	batch := NewSpriteBatch()
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		batch.items = batch.items[:0]
+		for i := 0; i < 1000; i++ {
+			batch.AddTexture(textures[i%len(textures)], Rectangle{}, Rectangle{}, Vector2{}, 0, White)
+		}
+		batch.groups()
+	}
+}
+
+func TestFacingSourceRec(t *testing.T) {
+	source := NewRectangle(16, 0, 16, 24)
+	tests := []struct {
+		facing int
+		width  float32
+	}{
+		{-1, -16},
+		{-5, -16},
+		{0, 16},
+		{1, 16},
+		{3, 16},
+	}
+
+	for _, test := range tests {
+		actual := facingSourceRec(source, test.facing)
+		if actual.Width != test.width || actual.X != source.X || actual.Y != source.Y || actual.Height != source.Height {
+			t.Errorf("facingSourceRec(%v, %d) = %v, want a width of %v", source, test.facing, actual, test.width)
+		}
+	}
+}
+
+func TestSpriteSheetFrameRect(t *testing.T) {
+	//A 4x2 grid of 16x24 frames, with a partial column that is ignored
+	sheet := NewSpriteSheet(Texture2D{Width: 70, Height: 48}, 16, 24)
+	if sheet.Columns() != 4 || sheet.Rows() != 2 || sheet.FrameCount() != 8 {
+		t.Fatalf("sheet is %dx%d with %d frames, want 4x2 with 8 frames", sheet.Columns(), sheet.Rows(), sheet.FrameCount())
+	}
+
+	tests := []struct {
+		index    int
+		expected Rectangle
+	}{
+		{0, NewRectangle(0, 0, 16, 24)},
+		{4, NewRectangle(0, 24, 16, 24)},
+		{7, NewRectangle(48, 24, 16, 24)},
+		{-1, Rectangle{}},
+		{8, Rectangle{}},
+	}
+
+	for _, test := range tests {
+		if actual := sheet.FrameRect(test.index); actual != test.expected {
+			t.Errorf("FrameRect(%d) = %v, want %v", test.index, actual, test.expected)
+		}
+	}
+}
+
+func TestSpriteSheetAnimation(t *testing.T) {
+	sheet := NewSpriteSheet(Texture2D{Width: 64, Height: 48}, 16, 24)
+
+	anim := sheet.Animation(6, 5, 0.1)
+	if len(anim.Frames) != 2 || anim.Frames[0] != sheet.FrameRect(6) || anim.Frames[1] != sheet.FrameRect(7) {
+		t.Errorf("Animation(6, 5) frames = %v, want frames 6 and 7", anim.Frames)
+	}
+
+	if anim := sheet.Animation(-2, 3, 0.1); len(anim.Frames) != 1 || anim.Frames[0] != sheet.FrameRect(0) {
+		t.Errorf("Animation(-2, 3) frames = %v, want frame 0", anim.Frames)
+	}
+
+	empty := NewSpriteSheet(Texture2D{Width: 64, Height: 48}, 0, 0)
+	if empty.FrameCount() != 0 || len(empty.Animation(0, 3, 0.1).Frames) != 0 {
+		t.Error("sheet with zero sized frames has frames")
+	}
+}
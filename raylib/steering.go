@@ -0,0 +1,68 @@
+package raylib
+
+import (
+	"math"
+	"math/rand"
+)
+
+//Seek calculates the steering force that turns an agent towards the target at full speed.
+// The force is limited to maxAccel, so it should be scaled by the frame time when applied to the velocity.
+func Seek(pos, vel, target Vector2, maxSpeed, maxAccel float32) Vector2 {
+	desired := withLength(target.Subtract(pos), maxSpeed)
+	return truncateVector(desired.Subtract(vel), maxAccel)
+}
+
+//Flee calculates the steering force that turns an agent away from the target at full speed.
+// The force is limited to maxAccel, so it should be scaled by the frame time when applied to the velocity.
+func Flee(pos, vel, target Vector2, maxSpeed, maxAccel float32) Vector2 {
+	desired := withLength(pos.Subtract(target), maxSpeed)
+	return truncateVector(desired.Subtract(vel), maxAccel)
+}
+
+//Arrive calculates the steering force that moves an agent towards the target, slowing down once it is within slowRadius so it stops on the target.
+// The force is limited to maxAccel, so it should be scaled by the frame time when applied to the velocity.
+func Arrive(pos, vel, target Vector2, maxSpeed, maxAccel, slowRadius float32) Vector2 {
+	offset := target.Subtract(pos)
+	distance := offset.Length()
+
+	speed := maxSpeed
+	if slowRadius > 0 && distance < slowRadius {
+		speed = maxSpeed * distance / slowRadius
+	}
+
+	desired := withLength(offset, speed)
+	return truncateVector(desired.Subtract(vel), maxAccel)
+}
+
+//Wander calculates a steering force that makes an agent meander randomly.
+// A target moves around a circle of the radius projected distance ahead of the agent, and the agent seeks it.
+// The angle is the current position of the target on the circle and must be kept between calls. Jitter is how far it can move in radians per second.
+func Wander(pos, vel Vector2, angle *float32, radius, distance, jitter, maxSpeed, maxAccel, dt float32) Vector2 {
+	*angle += (rand.Float32()*2 - 1) * jitter * dt
+
+	heading := NewVector2Right()
+	if vel.SqrLength() > 0 {
+		heading = withLength(vel, 1)
+	}
+
+	center := pos.Add(heading.Scale(distance))
+	offset := NewVector2(float32(math.Cos(float64(*angle))), float32(math.Sin(float64(*angle)))).Scale(radius)
+	return Seek(pos, vel, center.Add(offset), maxSpeed, maxAccel)
+}
+
+//withLength scales the vector to the length. Zero vectors stay zero.
+func withLength(v Vector2, length float32) Vector2 {
+	current := v.Length()
+	if current == 0 {
+		return NewVector2Zero()
+	}
+	return v.Scale(length / current)
+}
+
+//truncateVector limits the length of the vector. A max of 0 or less leaves the vector unchanged.
+func truncateVector(v Vector2, max float32) Vector2 {
+	if max <= 0 || v.SqrLength() <= max*max {
+		return v
+	}
+	return withLength(v, max)
+}
@@ -0,0 +1,48 @@
+package raylib
+
+import "testing"
+
+func TestSeek(t *testing.T) {
+	tests := []struct {
+		name     string
+		pos, vel Vector2
+		target   Vector2
+		maxAccel float32
+		expected Vector2
+	}{
+		{"from rest", NewVector2(0, 0), Vector2{}, NewVector2(10, 0), 100, NewVector2(5, 0)},
+		{"diagonal", NewVector2(1, 1), Vector2{}, NewVector2(4, 5), 100, NewVector2(3, 4)},
+		{"already at speed", NewVector2(0, 0), NewVector2(5, 0), NewVector2(10, 0), 100, Vector2{}},
+		{"moving away", NewVector2(0, 0), NewVector2(-5, 0), NewVector2(10, 0), 100, NewVector2(10, 0)},
+		{"turning", NewVector2(0, 0), NewVector2(0, 5), NewVector2(10, 0), 100, NewVector2(5, -5)},
+		{"limited", NewVector2(0, 0), NewVector2(-5, 0), NewVector2(10, 0), 2, NewVector2(2, 0)},
+		{"on the target", NewVector2(3, 3), NewVector2(1, 0), NewVector2(3, 3), 100, NewVector2(-1, 0)},
+	}
+
+	for _, test := range tests {
+		force := Seek(test.pos, test.vel, test.target, 5, test.maxAccel)
+		if !vector2NearlyEqual(force, test.expected) {
+			t.Errorf("%s: Seek() = %v, want %v", test.name, force, test.expected)
+		}
+	}
+}
+
+func TestSeekTowardsTarget(t *testing.T) {
+	//From rest, the force always points at the target and never exceeds the acceleration
+	pos := NewVector2(5, -3)
+	for _, target := range []Vector2{NewVector2(100, 0), NewVector2(-20, 40), NewVector2(5, -50)} {
+		force := Seek(pos, Vector2{}, target, 10, 3)
+		direction := target.Subtract(pos).Normalize()
+		if !vector2NearlyEqual(force.Normalize(), direction) {
+			t.Errorf("Seek() towards %v = %v, want the direction %v", target, force, direction)
+		}
+		if !nearlyEqual(force.Length(), 3) {
+			t.Errorf("Seek() towards %v has the length %v, want 3", target, force.Length())
+		}
+	}
+
+	//Flee pushes the opposite way
+	if force := Flee(pos, Vector2{}, NewVector2(100, -3), 10, 3); !vector2NearlyEqual(force, NewVector2(-3, 0)) {
+		t.Errorf("Flee() = %v, want %v", force, NewVector2(-3, 0))
+	}
+}
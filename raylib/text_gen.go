@@ -28,14 +28,21 @@ func LoadFont(fileName string) *Font {
 }
 
 //LoadFontEx : Load font from file with extended parameters
-func LoadFontEx(fileName string, fontSize int, fontChars int, charsCount int) (*Font, int) {
-	cfontChars := C.int(int32(fontChars))
+func LoadFontEx(fileName string, fontSize int, fontChars []int) *Font {
+	var cfontChars *C.int
+	if len(fontChars) > 0 {
+		cfontCharsarr := make([]C.int, len(fontChars))
+		for i, v := range fontChars {
+			cfontCharsarr[i] = C.int(v)
+		}
+		cfontChars = &cfontCharsarr[0]
+	}
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
-	res := C.LoadFontEx(cfileName, C.int(int32(fontSize)), &cfontChars, C.int(int32(charsCount)))
+	res := C.LoadFontEx(cfileName, C.int(int32(fontSize)), cfontChars, C.int(int32(len(fontChars))))
 	retval := newFontFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
-	return retval, int(int32(cfontChars))
+	return retval
 }
 
 //LoadFontFromImage : Load font from Image (XNA style)
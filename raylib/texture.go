@@ -4,7 +4,9 @@ package raylib
 //#include <stdlib.h>
 import "C"
 import (
+	"fmt"
 	"image"
+	"sync"
 	"unsafe"
 )
 
@@ -32,6 +34,174 @@ func LoadTextureFromGo(image image.Image) Texture2D {
 	return LoadTextureFromImage(img)
 }
 
+//LoadTextureFromGoImage uploads any Go image as a texture, such as *image.RGBA, *image.NRGBA, *image.Gray or *image.Paletted.
+// The pixels are converted to RGBA first. The texture is registered as an Unloadable.
+// This is LoadTextureFromGo with errors, as LoadTextureFromImage is already used for raylib images.
+func LoadTextureFromGoImage(img image.Image) (Texture2D, error) {
+	if img == nil {
+		return Texture2D{}, fmt.Errorf("cannot load a texture from a nil image")
+	}
+
+	size := img.Bounds().Size()
+	if size.X <= 0 || size.Y <= 0 {
+		return Texture2D{}, fmt.Errorf("cannot load a texture from an empty %dx%d image", size.X, size.Y)
+	}
+
+	texture := LoadTextureFromGo(img)
+	if texture.Id == 0 {
+		return texture, fmt.Errorf("failed to upload the %dx%d image to the GPU", size.X, size.Y)
+	}
+	return texture, nil
+}
+
+//UpdateRaw updates the texture with raw pixel data, for textures that are not in the RGBA format UpdateTexture expects.
+// The format must match the texture's format, and the data must be exactly the size of the texture in that format.
+func (texture *Texture2D) UpdateRaw(data []byte, format PixelFormat) error {
+	if PixelFormat(texture.Format) != format {
+		return fmt.Errorf("format %d does not match the texture format %d", format, texture.Format)
+	}
+
+	expected := GetPixelDataSize(int(texture.Width), int(texture.Height), format)
+	if len(data) != expected {
+		return fmt.Errorf("data is %d bytes but a %dx%d texture needs %d bytes", len(data), texture.Width, texture.Height, expected)
+	}
+
+	if expected == 0 {
+		return nil
+	}
+
+	ctexture := *texture.cptr()
+	C.UpdateTexture(ctexture, unsafe.Pointer(&data[0]))
+	invalidateTextureImage(*texture)
+	return nil
+}
+
+//textureImage is a copy of the pixels read back from a texture by ToImage
+type textureImage struct {
+	pixels        []Color
+	width, height int32
+}
+
+//textureImages caches the pixels read back from textures by ToImage, by the texture id
+var textureImages = make(map[uint32]textureImage)
+var textureImagesMutex sync.Mutex
+
+//ToImage reads the texture back from the GPU into a new image, such as for building collision masks.
+// Reading from the GPU is slow, so the pixels are cached until the texture is updated, unloaded or drawn to with BeginTextureMode.
+// Textures changed in other ways, such as by OpenGL calls made outside of raylib, keep returning the old pixels.
+// Reading a render texture between BeginTextureMode and EndTextureMode caches what has been drawn so far, which TextureMode clears when it ends.
+// Each call returns a new copy of the pixels, so the image belongs to the caller. It is registered as an Unloadable.
+func (texture Texture2D) ToImage() *Image {
+	textureImagesMutex.Lock()
+	defer textureImagesMutex.Unlock()
+
+	cached, ok := textureImages[texture.Id]
+	if !ok {
+		image := texture.GetTextureData()
+		if image.Width <= 0 || image.Height <= 0 {
+			return image
+		}
+
+		cached = textureImage{pixels: image.GetPixels(), width: image.Width, height: image.Height}
+		image.Unload()
+		textureImages[texture.Id] = cached
+	}
+
+	return LoadImageEx(cached.pixels, cached.width, cached.height)
+}
+
+//ToGoImage reads the texture back from the GPU into a new Go image, such as for inspecting a render texture on the CPU.
+// The raylib image used for the copy is unloaded before returning. See ToImage for a raylib image read through the cache.
+func (texture Texture2D) ToGoImage() (*image.RGBA, error) {
+	if texture.Id == 0 {
+		return nil, fmt.Errorf("cannot read back a texture that is not loaded")
+	}
+
+	img := texture.GetTextureData()
+	defer img.Unload()
+	if img.Width <= 0 || img.Height <= 0 {
+		return nil, fmt.Errorf("failed to read the %dx%d texture from the GPU", texture.Width, texture.Height)
+	}
+
+	pixels := img.GetPixels()
+	width, height := int(img.Width), int(img.Height)
+	if len(pixels) < width*height {
+		return nil, fmt.Errorf("read %d pixels from the GPU but a %dx%d texture needs %d", len(pixels), width, height, width*height)
+	}
+
+	//Go stores RGBA with premultiplied alpha, while raylib uses straight alpha
+	rgba := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i, c := range pixels[:width*height] {
+		a := uint32(c.A)
+		rgba.Pix[i*4+0] = uint8((uint32(c.R)*a + 127) / 255)
+		rgba.Pix[i*4+1] = uint8((uint32(c.G)*a + 127) / 255)
+		rgba.Pix[i*4+2] = uint8((uint32(c.B)*a + 127) / 255)
+		rgba.Pix[i*4+3] = c.A
+	}
+	return rgba, nil
+}
+
+//invalidateTextureImage forgets the cached pixels of the texture, so the next ToImage reads it again.
+// Images already returned by ToImage are copies, so they are left alone.
+func invalidateTextureImage(texture Texture2D) {
+	textureImagesMutex.Lock()
+	defer textureImagesMutex.Unlock()
+	delete(textureImages, texture.Id)
+}
+
+//DrawSliced draws the texture as a nine-slice into the destination, so the corners keep their size while the edges and center stretch.
+// The insets are the size in pixels of the left (X), top (Y), right (Z) and bottom (W) borders, and are clamped to the texture size.
+func (texture Texture2D) DrawSliced(dest Rectangle, insets Vector4, tint Color) {
+	sources, dests := nineSliceRects(float32(texture.Width), float32(texture.Height), dest, insets)
+	for i := range sources {
+		if sources[i].Width <= 0 || sources[i].Height <= 0 || dests[i].Width <= 0 || dests[i].Height <= 0 {
+			continue
+		}
+		DrawTexturePro(texture, sources[i], dests[i], NewVector2Zero(), 0, tint)
+	}
+}
+
+//nineSliceRects calculates the source and destination rectangles of each slice, from the top left to the bottom right.
+// If the destination is smaller than the borders, the borders are shrunk to fit.
+func nineSliceRects(width, height float32, dest Rectangle, insets Vector4) (sources, dests [9]Rectangle) {
+	left, right := clampInsetPair(insets.X, insets.Z, width)
+	top, bottom := clampInsetPair(insets.Y, insets.W, height)
+	destLeft, destRight := clampInsetPair(left, right, dest.Width)
+	destTop, destBottom := clampInsetPair(top, bottom, dest.Height)
+
+	srcX := [4]float32{0, left, width - right, width}
+	srcY := [4]float32{0, top, height - bottom, height}
+	dstX := [4]float32{dest.X, dest.X + destLeft, dest.X + dest.Width - destRight, dest.X + dest.Width}
+	dstY := [4]float32{dest.Y, dest.Y + destTop, dest.Y + dest.Height - destBottom, dest.Y + dest.Height}
+
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			sources[x+y*3] = NewRectangle(srcX[x], srcY[y], srcX[x+1]-srcX[x], srcY[y+1]-srcY[y])
+			dests[x+y*3] = NewRectangle(dstX[x], dstY[y], dstX[x+1]-dstX[x], dstY[y+1]-dstY[y])
+		}
+	}
+
+	return sources, dests
+}
+
+//clampInsetPair makes both insets positive and scales them down if together they are larger than the size
+func clampInsetPair(a, b, size float32) (float32, float32) {
+	if a < 0 {
+		a = 0
+	}
+	if b < 0 {
+		b = 0
+	}
+	if size <= 0 {
+		return 0, 0
+	}
+	if a+b > size {
+		scale := size / (a + b)
+		a, b = a*scale, b*scale
+	}
+	return a, b
+}
+
 //TextureCubemap type, actuall the same as a Texture2D
 type TextureCubemap Texture2D
 type CubemapLayoutType int32
@@ -108,22 +278,34 @@ func (t *NPatchInfo) cptr() *C.NPatchInfo {
 	return (*C.NPatchInfo)(unsafe.Pointer(t))
 }
 
+//TextureWrapMode is how texture coordinates outside of [0..1] are sampled. See Texture2D.SetWrap
 type TextureWrapMode int32
 
 const (
+	//WrapRepeat tiles the texture
 	WrapRepeat TextureWrapMode = iota
+	//WrapClamp stretches the edge pixels
 	WrapClamp
+	//WrapMirrorRepeat tiles the texture, mirroring every other tile
 	WrapMirrorRepeat
+	//WrapMirrorClamp mirrors the texture once, then stretches the edge pixels
 	WrapMirrorClamp
 )
 
+//TextureFilterMode is how a texture is sampled when it is scaled. See Texture2D.SetFilter
 type TextureFilterMode int32
 
 const (
+	//FilterPoint uses the nearest pixel, with no filtering
 	FilterPoint TextureFilterMode = iota
+	//FilterBilinear blends the nearest pixels
 	FilterBilinear
+	//FilterTrilinear blends the nearest pixels and between mipmaps. The texture needs mipmaps, see Texture2D.GenMipmaps
 	FilterTrilinear
+	//FilterAnisotropic4x uses anisotropic filtering at 4x
 	FilterAnisotropic4x
+	//FilterAnisotropic8x uses anisotropic filtering at 8x
 	FilterAnisotropic8x
+	//FilterAnisotropic16x uses anisotropic filtering at 16x
 	FilterAnisotropic16x
 )
@@ -128,6 +128,7 @@ func UnloadImage(image *Image) {
 
 //Unload : Unload texture from GPU memory (VRAM)
 func (texture Texture2D) Unload() {
+	invalidateTextureImage(texture)
 	ctexture := *texture.cptr()
 	C.UnloadTexture(ctexture)
 	UnregisterUnloadable(texture)
@@ -272,6 +273,7 @@ func (texture *Texture2D) UpdateTexture(pixels []Color) {
 	ctexture := *texture.cptr()
 	cpixels := pixels[0].cptr()
 	C.UpdateTexture(ctexture, unsafe.Pointer(cpixels))
+	invalidateTextureImage(*texture)
 }
 
 //UpdateTexture : Update GPU texture with new data
@@ -386,8 +388,14 @@ func ImageAlphaPremultiply(image *Image) {
 	image.AlphaPremultiply()
 }
 
-//Crop : Crop an image to a defined rectangle
+//Crop : Crop an image to a defined rectangle. The rectangle is clamped to the bounds of the image, and the image is left unchanged if they do not overlap.
 func (image *Image) Crop(crop Rectangle) {
+	crop, ok := clampImageRect(crop, image.Width, image.Height)
+	if !ok {
+		TraceLog(LogWarning, "[IMAGE] Crop rectangle does not overlap the ", image.Width, "x", image.Height, " image")
+		return
+	}
+
 	ccrop := *crop.cptr()
 	cimage := image.cptr()
 	C.ImageCrop(cimage, ccrop)
@@ -399,8 +407,13 @@ func ImageCrop(image *Image, crop Rectangle) {
 	image.Crop(crop)
 }
 
-//Resize : Resize image (Bicubic scaling algorithm)
+//Resize : Resize image (Bicubic scaling algorithm). The image is left unchanged if the new size is not positive.
 func (image *Image) Resize(newWidth int, newHeight int) {
+	if newWidth <= 0 || newHeight <= 0 {
+		TraceLog(LogWarning, "[IMAGE] Cannot resize the image to ", newWidth, "x", newHeight)
+		return
+	}
+
 	cimage := image.cptr()
 	C.ImageResize(cimage, C.int(int32(newWidth)), C.int(int32(newHeight)))
 }
@@ -411,8 +424,13 @@ func ImageResize(image *Image, newWidth int, newHeight int) {
 	image.Resize(newWidth, newHeight)
 }
 
-//ResizeNN : Resize image (Nearest-Neighbor scaling algorithm)
+//ResizeNN : Resize image (Nearest-Neighbor scaling algorithm). The image is left unchanged if the new size is not positive.
 func (image *Image) ResizeNN(newWidth int, newHeight int) {
+	if newWidth <= 0 || newHeight <= 0 {
+		TraceLog(LogWarning, "[IMAGE] Cannot resize the image to ", newWidth, "x", newHeight)
+		return
+	}
+
 	cimage := image.cptr()
 	C.ImageResizeNN(cimage, C.int(int32(newWidth)), C.int(int32(newHeight)))
 }
@@ -508,8 +526,15 @@ func ImageTextEx(font Font, text string, fontSize float32, spacing float32, tint
 	return newImageFromPointer(unsafe.Pointer(&res))
 }
 
-//Draw : Draw a source image within a destination image (tint applied to source)
+//Draw : Draw a source image within a destination image (tint applied to source). Nothing is drawn if either rectangle or image is empty.
 func (dst *Image) Draw(src *Image, srcRec Rectangle, dstRec Rectangle, tint Color) {
+	if srcRec.Width <= 0 || srcRec.Height <= 0 || dstRec.Width <= 0 || dstRec.Height <= 0 {
+		return
+	}
+	if src.Width <= 0 || src.Height <= 0 || dst.Width <= 0 || dst.Height <= 0 {
+		return
+	}
+
 	ctint := *tint.cptr()
 	cdstRec := *dstRec.cptr()
 	csrcRec := *srcRec.cptr()
@@ -552,8 +577,12 @@ func ImageDrawRectangleLines(dst *Image, rec Rectangle, thick int, color Color)
 	dst.DrawRectangleLines(rec, thick, color)
 }
 
-//DrawText : Draw text (default font) within an image (destination)
+//DrawText : Draw text (default font) within an image (destination). Nothing is drawn if the text is empty or the font size is not positive.
 func (dst *Image) DrawText(position Vector2, text string, fontSize int, color Color) {
+	if text == "" || fontSize <= 0 {
+		return
+	}
+
 	ccolor := *color.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -568,8 +597,12 @@ func ImageDrawText(dst *Image, position Vector2, text string, fontSize int, colo
 	dst.DrawText(position, text, fontSize, color)
 }
 
-//DrawTextEx : Draw text (custom sprite font) within an image (destination)
+//DrawTextEx : Draw text (custom sprite font) within an image (destination). Nothing is drawn if the text is empty or the font size is not positive.
 func (dst *Image) DrawTextEx(position Vector2, font *Font, text string, fontSize float32, spacing float32, color Color) {
+	if text == "" || fontSize <= 0 {
+		return
+	}
+
 	ccolor := *color.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -781,28 +814,43 @@ func GenImageCellular(width int, height int, tileSize int) *Image {
 	return retval
 }
 
-//GenTextureMipmaps : Generate GPU mipmaps for a texture
-func (texture Texture2D) GenTextureMipmaps() {
+//GenMipmaps : Generate GPU mipmaps for a texture. The number of mipmaps is updated on the texture.
+func (texture *Texture2D) GenMipmaps() {
 	ctexture := texture.cptr()
 	C.GenTextureMipmaps(ctexture)
 }
 
 //GenTextureMipmaps : Generate GPU mipmaps for a texture
-//Recommended to use texture.GenTextureMipmaps() instead
+//
+//Deprecated: The number of mipmaps is only updated on a copy of the texture. Use texture.GenMipmaps() instead.
+func (texture Texture2D) GenTextureMipmaps() {
+	texture.GenMipmaps()
+}
+
+//GenTextureMipmaps : Generate GPU mipmaps for a texture
+//
+//Deprecated: The number of mipmaps is only updated on a copy of the texture. Use texture.GenMipmaps() instead.
 func GenTextureMipmaps(texture Texture2D) {
-	texture.GenTextureMipmaps()
+	texture.GenMipmaps()
 }
 
-//SetTextureFilter : Set texture scaling filter mode
-func (texture Texture2D) SetTextureFilter(filterMode TextureFilterMode) {
+//SetFilter : Set texture scaling filter mode
+func (texture *Texture2D) SetFilter(filterMode TextureFilterMode) {
 	ctexture := *texture.cptr()
 	C.SetTextureFilter(ctexture, C.int(int32(filterMode)))
 }
 
 //SetTextureFilter : Set texture scaling filter mode
-//Recommended to use texture.SetTextureFilter(filterMode) instead
+//
+//Deprecated: Use texture.SetFilter(filterMode) instead.
+func (texture Texture2D) SetTextureFilter(filterMode TextureFilterMode) {
+	texture.SetFilter(filterMode)
+}
+
+//SetTextureFilter : Set texture scaling filter mode
+//Recommended to use texture.SetFilter(filterMode) instead
 func SetTextureFilter(texture Texture2D, filterMode TextureFilterMode) {
-	texture.SetTextureFilter(filterMode)
+	texture.SetFilter(filterMode)
 }
 
 //SetWrap : Set texture wrapping mode
@@ -0,0 +1,223 @@
+//go:build integration
+// +build integration
+
+package raylib
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestToImageAfterTextureMode(t *testing.T) {
+	requireWindow(t)
+
+	var first, second Color
+	onMainThread(func() {
+		target := LoadRenderTexture(4, 4)
+		defer target.Unload()
+
+		TextureMode(target, func() { ClearBackground(Red) })
+		image := target.Texture.ToImage()
+		first = image.GetPixels()[0]
+		image.Unload()
+
+		//Drawing again must not return the cached pixels
+		TextureMode(target, func() { ClearBackground(Blue) })
+		image = target.Texture.ToImage()
+		second = image.GetPixels()[0]
+		image.Unload()
+	})
+
+	if first != Red || second != Blue {
+		t.Errorf("ToImage() after drawing red then blue = %v then %v", first, second)
+	}
+}
+
+func TestToGoImage(t *testing.T) {
+	requireWindow(t)
+
+	pixels := []Color{Red, Green, Blue, NewColor(200, 100, 50, 128)}
+	var rgba *image.RGBA
+	var err error
+	onMainThread(func() {
+		img := LoadImageEx(pixels, 2, 2)
+		defer img.Unload()
+		texture := LoadTextureFromImage(img)
+		defer texture.Unload()
+
+		rgba, err = texture.ToGoImage()
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := rgba.Bounds().Size(); size.X != 2 || size.Y != 2 {
+		t.Fatalf("ToGoImage() is %v, want 2x2", size)
+	}
+
+	//Opaque pixels come back as they are, while the translucent one is premultiplied
+	expected := []color.RGBA{{230, 41, 55, 255}, {0, 228, 48, 255}, {0, 121, 241, 255}, {100, 50, 25, 128}}
+	for i, want := range expected {
+		if got := rgba.RGBAAt(i%2, i/2); got != want {
+			t.Errorf("pixel %d, %d = %v, want %v", i%2, i/2, got, want)
+		}
+	}
+}
+
+func TestUpdateRaw(t *testing.T) {
+	requireWindow(t)
+
+	var rgba *image.RGBA
+	var err error
+	onMainThread(func() {
+		img := GenImageColor(2, 1, Black)
+		defer img.Unload()
+		img.SetFormat(UncompressedGrayscale)
+		texture := LoadTextureFromImage(img)
+		defer texture.Unload()
+
+		if err = texture.UpdateRaw([]byte{64, 200}, UncompressedGrayscale); err == nil {
+			rgba, err = texture.ToGoImage()
+		}
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left, right := rgba.RGBAAt(0, 0), rgba.RGBAAt(1, 0); left != (color.RGBA{64, 64, 64, 255}) || right != (color.RGBA{200, 200, 200, 255}) {
+		t.Errorf("pixels after UpdateRaw() = %v and %v, want gray 64 and 200", left, right)
+	}
+}
+
+func TestLoadTextureFromGoImage(t *testing.T) {
+	requireWindow(t)
+
+	bounds := image.Rect(0, 0, 2, 1)
+	gray := image.NewGray(bounds)
+	gray.SetGray(0, 0, color.Gray{Y: 255})
+	paletted := image.NewPaletted(bounds, color.Palette{color.White, color.Black})
+	paletted.SetColorIndex(1, 0, 1)
+	nrgba := image.NewNRGBA(bounds)
+	nrgba.SetNRGBA(0, 0, color.NRGBA{255, 255, 255, 255})
+	nrgba.SetNRGBA(1, 0, color.NRGBA{0, 0, 0, 255})
+	rgba := image.NewRGBA(bounds)
+	rgba.SetRGBA(0, 0, color.RGBA{255, 255, 255, 255})
+	rgba.SetRGBA(1, 0, color.RGBA{0, 0, 0, 255})
+
+	for name, img := range map[string]image.Image{"RGBA": rgba, "NRGBA": nrgba, "Gray": gray, "Paletted": paletted} {
+		var result *image.RGBA
+		var err error
+		onMainThread(func() {
+			var texture Texture2D
+			if texture, err = LoadTextureFromGoImage(img); err != nil {
+				return
+			}
+			defer texture.Unload()
+			result, err = texture.ToGoImage()
+		})
+
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if left, right := result.RGBAAt(0, 0), result.RGBAAt(1, 0); left != (color.RGBA{255, 255, 255, 255}) || right != (color.RGBA{0, 0, 0, 255}) {
+			t.Errorf("%s: texture pixels = %v and %v, want white and black", name, left, right)
+		}
+	}
+}
+
+func TestTrilinearFilter(t *testing.T) {
+	requireWindow(t)
+
+	var mipmaps int32
+	var sampled Color
+	onMainThread(func() {
+		img := GenImageColor(16, 16, Red)
+		defer img.Unload()
+		texture := LoadTextureFromImage(img)
+		defer texture.Unload()
+
+		texture.GenMipmaps()
+		texture.SetFilter(FilterTrilinear)
+		mipmaps = texture.Mipmaps
+
+		//Drawing it shrunk to fill the target samples from the mipmaps, which are the same solid colour
+		target := LoadRenderTexture(8, 8)
+		defer target.Unload()
+		TextureMode(target, func() {
+			ClearBackground(Blank)
+			DrawTextureEx(texture, NewVector2Zero(), 0, 0.5, White)
+		})
+		image := target.Texture.ToImage()
+		defer image.Unload()
+		sampled = image.GetPixels()[0]
+	})
+
+	if mipmaps <= 1 {
+		t.Errorf("texture has %d mipmaps after GenMipmaps(), want more than 1", mipmaps)
+	}
+	if sampled != Red {
+		t.Errorf("trilinear filtered pixel = %v, want %v", sampled, Red)
+	}
+}
+
+func TestLoadVirtualCanvasOwnsTarget(t *testing.T) {
+	requireWindow(t)
+
+	var canvas *VirtualCanvas
+	var targetRegistered, canvasRegistered bool
+	onMainThread(func() {
+		canvas = LoadVirtualCanvas(8, 8)
+		targetRegistered = isRegistered(canvas.Target)
+		canvasRegistered = isRegistered(canvas)
+		canvas.Unload()
+	})
+
+	//Only the canvas is registered, so UnloadAll frees the render texture once
+	if targetRegistered || !canvasRegistered {
+		t.Errorf("after LoadVirtualCanvas() the target is registered %v and the canvas %v, want only the canvas", targetRegistered, canvasRegistered)
+	}
+}
+
+func TestLoadMinimapOwnsTarget(t *testing.T) {
+	requireWindow(t)
+
+	var minimap *Minimap
+	var targetRegistered, minimapRegistered bool
+	onMainThread(func() {
+		minimap = LoadMinimap(NewRectangle(0, 0, 100, 100), 8, 8)
+		targetRegistered = isRegistered(minimap.Target)
+		minimapRegistered = isRegistered(minimap)
+		minimap.Unload()
+	})
+
+	//Only the minimap is registered, so UnloadAll frees the render texture once
+	if targetRegistered || !minimapRegistered {
+		t.Errorf("after LoadMinimap() the target is registered %v and the minimap %v, want only the minimap", targetRegistered, minimapRegistered)
+	}
+}
+
+func TestDynamicFontOwnsAtlasTexture(t *testing.T) {
+	requireWindow(t)
+
+	var fontRegistered, atlasRegistered, grownRegistered bool
+	onMainThread(func() {
+		font := LoadDynamicFont("../raylib-example/resources/fonts/AnonymousPro-Bold.ttf", 20)
+		defer font.Unload()
+		fontRegistered = isRegistered(font)
+		atlasRegistered = isRegistered(font.texture)
+
+		//Growing replaces the texture, and the new one is owned by the font too
+		previous := font.texture
+		font.grow(font.atlasSize * 2)
+		grownRegistered = isRegistered(font.texture) || isRegistered(previous)
+	})
+
+	if !fontRegistered || atlasRegistered {
+		t.Errorf("after LoadDynamicFont() the font is registered %v and the atlas %v, want only the font", fontRegistered, atlasRegistered)
+	}
+	if grownRegistered {
+		t.Error("after grow() an atlas texture is registered, want the font to own both the old and new texture")
+	}
+}
@@ -0,0 +1,182 @@
+package raylib
+
+import (
+	"image"
+	"testing"
+)
+
+//cacheTextureImage puts pixels of the colour into the ToImage cache, as if they had been read back from the GPU
+func cacheTextureImage(t *testing.T, texture Texture2D, color Color) {
+	t.Helper()
+	pixels := make([]Color, texture.Width*texture.Height)
+	for i := range pixels {
+		pixels[i] = color
+	}
+
+	textureImagesMutex.Lock()
+	textureImages[texture.Id] = textureImage{pixels: pixels, width: texture.Width, height: texture.Height}
+	textureImagesMutex.Unlock()
+	t.Cleanup(func() { invalidateTextureImage(texture) })
+}
+
+//isTextureImageCached checks if the texture has pixels in the ToImage cache
+func isTextureImageCached(texture Texture2D) bool {
+	textureImagesMutex.Lock()
+	defer textureImagesMutex.Unlock()
+	_, ok := textureImages[texture.Id]
+	return ok
+}
+
+func TestToImageCacheHit(t *testing.T) {
+	texture := Texture2D{Id: 4001, Width: 2, Height: 2}
+	cacheTextureImage(t, texture, Red)
+
+	//The cached pixels are returned without reading from the GPU, in a new image each time
+	first := texture.ToImage()
+	second := texture.ToImage()
+	if first == second || first.data == second.data {
+		t.Fatal("ToImage() returned the same image twice, want a copy each time")
+	}
+	for i, image := range []*Image{first, second} {
+		if image.Width != 2 || image.Height != 2 {
+			t.Errorf("image %d from ToImage() is %dx%d, want 2x2", i, image.Width, image.Height)
+		}
+		for _, p := range image.GetPixels() {
+			if p != Red {
+				t.Errorf("image %d from ToImage() has pixel %v, want the cached red", i, p)
+				break
+			}
+		}
+	}
+
+	//The images belong to the caller, so unloading one or clearing the cache leaves the other readable
+	first.Unload()
+	invalidateTextureImage(texture)
+	if isTextureImageCached(texture) {
+		t.Error("invalidateTextureImage() left the pixels cached")
+	}
+	if p := second.GetPixels()[0]; p != Red {
+		t.Errorf("image from ToImage() after clearing the cache has pixel %v, want red", p)
+	}
+	second.Unload()
+}
+
+func TestTextureModeInvalidatesImage(t *testing.T) {
+	target := RenderTexture2D{Texture: Texture2D{Id: 4002, Width: 2, Height: 2}}
+	other := Texture2D{Id: 4003, Width: 2, Height: 2}
+	cacheTextureImage(t, target.Texture, Red)
+	cacheTextureImage(t, other, Blue)
+
+	//BeginTextureMode clears the pixels when starting and textureMode clears them again when finishing
+	begin := func(target RenderTexture2D) { invalidateTextureImage(target.Texture) }
+	ended := false
+	textureMode(target, begin, func() { ended = true }, func() {
+		if isTextureImageCached(target.Texture) {
+			t.Error("beginning texture mode left the pixels cached")
+		}
+		cacheTextureImage(t, target.Texture, Green)
+	})
+	if !ended {
+		t.Error("textureMode() did not end the mode")
+	}
+	if isTextureImageCached(target.Texture) {
+		t.Error("ending texture mode left the pixels cached")
+	}
+
+	if !isTextureImageCached(other) {
+		t.Error("texture mode cleared the pixels of another texture")
+	}
+}
+
+func TestNineSliceRects(t *testing.T) {
+	insets := NewVector4(4, 6, 8, 10)
+	sources, dests := nineSliceRects(30, 30, NewRectangle(100, 200, 60, 40), insets)
+
+	expectedSources := [9]Rectangle{
+		NewRectangle(0, 0, 4, 6), NewRectangle(4, 0, 18, 6), NewRectangle(22, 0, 8, 6),
+		NewRectangle(0, 6, 4, 14), NewRectangle(4, 6, 18, 14), NewRectangle(22, 6, 8, 14),
+		NewRectangle(0, 20, 4, 10), NewRectangle(4, 20, 18, 10), NewRectangle(22, 20, 8, 10),
+	}
+	expectedDests := [9]Rectangle{
+		NewRectangle(100, 200, 4, 6), NewRectangle(104, 200, 48, 6), NewRectangle(152, 200, 8, 6),
+		NewRectangle(100, 206, 4, 24), NewRectangle(104, 206, 48, 24), NewRectangle(152, 206, 8, 24),
+		NewRectangle(100, 230, 4, 10), NewRectangle(104, 230, 48, 10), NewRectangle(152, 230, 8, 10),
+	}
+
+	for i := range sources {
+		if sources[i] != expectedSources[i] {
+			t.Errorf("source %d = %v, want %v", i, sources[i], expectedSources[i])
+		}
+		if dests[i] != expectedDests[i] {
+			t.Errorf("dest %d = %v, want %v", i, dests[i], expectedDests[i])
+		}
+	}
+}
+
+func TestNineSliceRectsShrunk(t *testing.T) {
+	//The destination is narrower than the left and right borders, so they shrink in proportion and the middle disappears
+	_, dests := nineSliceRects(30, 30, NewRectangle(0, 0, 6, 40), NewVector4(4, 6, 8, 10))
+
+	expected := [3]Rectangle{NewRectangle(0, 0, 2, 6), NewRectangle(2, 0, 0, 6), NewRectangle(2, 0, 4, 6)}
+	for i := range expected {
+		if dests[i] != expected[i] {
+			t.Errorf("dest %d = %v, want %v", i, dests[i], expected[i])
+		}
+	}
+}
+
+func TestToGoImageNotLoaded(t *testing.T) {
+	if img, err := (Texture2D{Width: 4, Height: 4}).ToGoImage(); err == nil || img != nil {
+		t.Errorf("ToGoImage() of an unloaded texture = %v, %v, want an error", img, err)
+	}
+}
+
+func TestUpdateRawRejectsWrongData(t *testing.T) {
+	texture := Texture2D{Id: 4004, Width: 4, Height: 2, Format: int32(UncompressedR8g8b8a8)}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		format PixelFormat
+	}{
+		{"too short", make([]byte, 4*2*4-1), UncompressedR8g8b8a8},
+		{"too long", make([]byte, 4*2*4+1), UncompressedR8g8b8a8},
+		{"empty", nil, UncompressedR8g8b8a8},
+		{"wrong format", make([]byte, 4*2), UncompressedGrayscale},
+	}
+
+	//The data is checked before anything is sent to the GPU, so the cached image is left alone
+	cacheTextureImage(t, texture, Red)
+	for _, test := range tests {
+		if err := texture.UpdateRaw(test.data, test.format); err == nil {
+			t.Errorf("%s: UpdateRaw() of %d bytes succeeded", test.name, len(test.data))
+		}
+	}
+	if !isTextureImageCached(texture) {
+		t.Error("a rejected UpdateRaw() cleared the cached image")
+	}
+
+	//An empty texture needs no data
+	empty := Texture2D{Format: int32(UncompressedR8g8b8a8)}
+	if err := empty.UpdateRaw(nil, UncompressedR8g8b8a8); err != nil {
+		t.Errorf("UpdateRaw() of an empty texture = %v, want no error", err)
+	}
+}
+
+func TestLoadTextureFromGoImageInvalid(t *testing.T) {
+	//Both are rejected before anything is uploaded, so no window is needed
+	if texture, err := LoadTextureFromGoImage(nil); err == nil || texture.Id != 0 {
+		t.Errorf("LoadTextureFromGoImage(nil) = %v, %v, want an error", texture, err)
+	}
+	if texture, err := LoadTextureFromGoImage(image.NewRGBA(image.Rect(0, 0, 0, 4))); err == nil || texture.Id != 0 {
+		t.Errorf("LoadTextureFromGoImage() of an empty image = %v, %v, want an error", texture, err)
+	}
+}
+
+//The deprecated texture functions keep their old signatures, so code written against them still compiles
+var (
+	_ func(Texture2D)                    = GenTextureMipmaps
+	_ func(Texture2D)                    = Texture2D.GenTextureMipmaps
+	_ func(Texture2D, TextureFilterMode) = SetTextureFilter
+	_ func(Texture2D, TextureFilterMode) = Texture2D.SetTextureFilter
+)
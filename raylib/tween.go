@@ -0,0 +1,179 @@
+package raylib
+
+//EasingFunction maps a linear progress [0..1] to an eased progress.
+type EasingFunction func(t float32) float32
+
+//EaseLinear does not ease the progress at all
+func EaseLinear(t float32) float32 { return t }
+
+//EaseInQuad eases the progress quadratically, accelerating from zero
+func EaseInQuad(t float32) float32 { return t * t }
+
+//EaseOutQuad eases the progress quadratically, decelerating to zero
+func EaseOutQuad(t float32) float32 { return t * (2 - t) }
+
+//EaseInOutQuad eases the progress quadratically, accelerating then decelerating
+func EaseInOutQuad(t float32) float32 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+//Tween interpolates a value from one number to another over a duration
+type Tween struct {
+	//From is the starting value
+	From float32
+	//To is the final value
+	To float32
+	//Duration is the time in seconds the tween takes
+	Duration float32
+	//Easing is the easing applied to the progress. Linear if nil.
+	Easing EasingFunction
+	//OnUpdate is called with the new value every time the tween is updated
+	OnUpdate func(value float32)
+
+	elapsed float32
+}
+
+//NewTween creates a new tween
+func NewTween(from, to, duration float32, easing EasingFunction, onUpdate func(value float32)) *Tween {
+	return &Tween{From: from, To: to, Duration: duration, Easing: easing, OnUpdate: onUpdate}
+}
+
+//Update advances the tween by the delta time. Returns true when the tween has completed.
+func (t *Tween) Update(dt float32) bool {
+	t.advance(dt)
+	return t.IsComplete()
+}
+
+//advance steps the tween and returns the time that was left over after it completed.
+func (t *Tween) advance(dt float32) float32 {
+	t.elapsed += dt
+	leftover := float32(0)
+	if t.elapsed >= t.Duration {
+		leftover = t.elapsed - t.Duration
+		t.elapsed = t.Duration
+	}
+
+	if t.OnUpdate != nil {
+		t.OnUpdate(t.Value())
+	}
+
+	return leftover
+}
+
+//Progress returns the linear progress of the tween [0..1]
+func (t *Tween) Progress() float32 {
+	if t.Duration <= 0 {
+		return 1
+	}
+	return Clamp32(t.elapsed/t.Duration, 0, 1)
+}
+
+//Value returns the current eased value of the tween
+func (t *Tween) Value() float32 {
+	progress := t.Progress()
+	if t.Easing != nil {
+		progress = t.Easing(progress)
+	}
+	return t.From + (t.To-t.From)*progress
+}
+
+//IsComplete returns true if the tween has reached its duration
+func (t *Tween) IsComplete() bool { return t.elapsed >= t.Duration }
+
+//Reset rewinds the tween back to the start
+func (t *Tween) Reset() { t.elapsed = 0 }
+
+//Sequence runs a series of tween steps one after another. Each step is one or more tweens that run in parallel.
+type Sequence struct {
+	//OnComplete is called once the last step has finished
+	OnComplete func()
+
+	steps    [][]*Tween
+	current  int
+	complete bool
+}
+
+//NewSequence creates a new empty sequence
+func NewSequence() *Sequence {
+	return &Sequence{steps: make([][]*Tween, 0)}
+}
+
+//Then adds a tween that runs after all the previous steps have finished. Nil tweens are ignored.
+func (s *Sequence) Then(tween *Tween) *Sequence {
+	if tween != nil {
+		s.steps = append(s.steps, []*Tween{tween})
+	}
+	return s
+}
+
+//Parallel adds a group of tweens that run together after all the previous steps have finished.
+// The step finishes once the longest of the tweens has finished. Nil tweens are ignored.
+func (s *Sequence) Parallel(tweens ...*Tween) *Sequence {
+	step := make([]*Tween, 0, len(tweens))
+	for _, tween := range tweens {
+		if tween != nil {
+			step = append(step, tween)
+		}
+	}
+
+	if len(step) > 0 {
+		s.steps = append(s.steps, step)
+	}
+	return s
+}
+
+//Update advances the sequence by the delta time. Time left over from a finished step carries into the next step.
+// Returns true when the sequence has completed. An empty sequence completes on its first update.
+func (s *Sequence) Update(dt float32) bool {
+	if s.complete {
+		return true
+	}
+
+	for s.current < len(s.steps) {
+		leftover := dt
+		stepComplete := true
+		for _, tween := range s.steps[s.current] {
+			if tween.IsComplete() {
+				continue
+			}
+
+			remaining := tween.advance(dt)
+			if !tween.IsComplete() {
+				stepComplete = false
+			}
+			if remaining < leftover {
+				leftover = remaining
+			}
+		}
+
+		if !stepComplete {
+			return false
+		}
+
+		s.current++
+		dt = leftover
+	}
+
+	s.complete = true
+	if s.OnComplete != nil {
+		s.OnComplete()
+	}
+	return true
+}
+
+//IsComplete returns true if every step has finished
+func (s *Sequence) IsComplete() bool { return s.complete }
+
+//Reset rewinds the sequence and all of its tweens back to the start
+func (s *Sequence) Reset() {
+	for _, step := range s.steps {
+		for _, tween := range step {
+			tween.Reset()
+		}
+	}
+	s.current = 0
+	s.complete = false
+}
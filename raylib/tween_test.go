@@ -0,0 +1,105 @@
+package raylib
+
+import "testing"
+
+func TestSequenceSequential(t *testing.T) {
+	first := NewTween(0, 10, 1, nil, nil)
+	second := NewTween(0, 10, 1, nil, nil)
+
+	completions := 0
+	sequence := NewSequence().Then(first).Then(second)
+	sequence.OnComplete = func() { completions++ }
+
+	if sequence.Update(0.5) || first.Value() != 5 || second.Value() != 0 {
+		t.Fatalf("after 0.5s the values are %v and %v, want 5 and 0", first.Value(), second.Value())
+	}
+
+	//The 0.25s left over from the first tween carries into the second
+	if sequence.Update(0.75) || !first.IsComplete() || second.Value() != 2.5 {
+		t.Fatalf("after 1.25s the values are %v and %v, want 10 and 2.5", first.Value(), second.Value())
+	}
+
+	if !sequence.Update(0.75) || !second.IsComplete() {
+		t.Fatalf("sequence is not complete after 2s")
+	}
+	if !sequence.Update(1) || completions != 1 {
+		t.Errorf("OnComplete called %d times, want 1", completions)
+	}
+}
+
+func TestSequenceParallel(t *testing.T) {
+	short := NewTween(0, 1, 1, nil, nil)
+	long := NewTween(0, 1, 2, nil, nil)
+	after := NewTween(0, 1, 1, nil, nil)
+	sequence := NewSequence().Parallel(short, long).Then(after)
+
+	//Both run at once, and the step waits for the longest
+	sequence.Update(1.5)
+	if !short.IsComplete() || long.Progress() != 0.75 || after.Progress() != 0 {
+		t.Fatalf("after 1.5s the progress is %v, %v and %v, want 1, 0.75 and 0", short.Progress(), long.Progress(), after.Progress())
+	}
+
+	//Only the time left over from the longest tween carries into the next step
+	sequence.Update(1)
+	if !long.IsComplete() || after.Progress() != 0.5 {
+		t.Fatalf("after 2.5s the progress is %v and %v, want 1 and 0.5", long.Progress(), after.Progress())
+	}
+
+	if !sequence.Update(0.5) {
+		t.Error("sequence is not complete after 3s")
+	}
+}
+
+func TestSequenceCarriesOverManySteps(t *testing.T) {
+	tweens := []*Tween{NewTween(0, 1, 0.25, nil, nil), NewTween(0, 1, 0.25, nil, nil), NewTween(0, 1, 1, nil, nil)}
+	sequence := NewSequence().Then(tweens[0]).Then(tweens[1]).Then(tweens[2])
+
+	//A single large update can finish several steps
+	sequence.Update(1)
+	if !tweens[0].IsComplete() || !tweens[1].IsComplete() || tweens[2].Progress() != 0.5 {
+		t.Errorf("after 1s the last tween progress is %v, want 0.5 with the others complete", tweens[2].Progress())
+	}
+}
+
+func TestSequenceEmpty(t *testing.T) {
+	completions := 0
+	sequence := NewSequence()
+	sequence.OnComplete = func() { completions++ }
+
+	if !sequence.Update(0) || !sequence.IsComplete() {
+		t.Error("empty sequence did not complete on its first update")
+	}
+	sequence.Update(1)
+	if completions != 1 {
+		t.Errorf("OnComplete called %d times, want 1", completions)
+	}
+}
+
+func TestSequenceIgnoresNil(t *testing.T) {
+	tween := NewTween(0, 1, 1, nil, nil)
+	sequence := NewSequence().Then(nil).Parallel(nil, tween, nil).Parallel(nil)
+
+	if sequence.Update(0.5) {
+		t.Fatal("sequence completed before its tween")
+	}
+	if !sequence.Update(0.5) {
+		t.Error("sequence with nil tweens did not complete")
+	}
+}
+
+func TestSequenceReset(t *testing.T) {
+	tween := NewTween(0, 1, 1, nil, nil)
+	completions := 0
+	sequence := NewSequence().Then(tween)
+	sequence.OnComplete = func() { completions++ }
+
+	sequence.Update(2)
+	sequence.Reset()
+	if sequence.IsComplete() || tween.Progress() != 0 {
+		t.Fatal("Reset() did not rewind the sequence")
+	}
+	sequence.Update(2)
+	if completions != 2 {
+		t.Errorf("OnComplete called %d times after playing twice, want 2", completions)
+	}
+}
@@ -0,0 +1,83 @@
+package raylib
+
+import "unicode/utf8"
+
+//TypewriterText reveals a string a few characters at a time, like dialogue in an RPG.
+// Characters are counted by rune, so multibyte characters are never cut in half.
+type TypewriterText struct {
+	//Text is the full text being revealed
+	Text string
+	//CharsPerSecond is how many characters are revealed every second. If this is zero, the text is shown immediately.
+	CharsPerSecond float32
+
+	elapsed float32
+	skipped bool
+}
+
+//NewTypewriterText creates a new typewriter with nothing revealed yet
+func NewTypewriterText(text string, charsPerSecond float32) *TypewriterText {
+	return &TypewriterText{Text: text, CharsPerSecond: charsPerSecond}
+}
+
+//Update reveals more characters based on the delta time
+func (tw *TypewriterText) Update(dt float32) {
+	if !tw.IsComplete() {
+		tw.elapsed += dt
+	}
+}
+
+//Skip reveals the entire text immediately
+func (tw *TypewriterText) Skip() { tw.skipped = true }
+
+//Reset hides the text again so it can be revealed from the start
+func (tw *TypewriterText) Reset() {
+	tw.elapsed = 0
+	tw.skipped = false
+}
+
+//SetText replaces the text and starts revealing it from the start
+func (tw *TypewriterText) SetText(text string) {
+	tw.Text = text
+	tw.Reset()
+}
+
+//RevealedCount is the number of characters that have been revealed
+func (tw *TypewriterText) RevealedCount() int {
+	total := utf8.RuneCountInString(tw.Text)
+	if tw.skipped {
+		return total
+	}
+
+	count := int(tw.elapsed * tw.CharsPerSecond)
+	if count > total || tw.CharsPerSecond <= 0 {
+		return total
+	}
+	return count
+}
+
+//Revealed gets the part of the text that has been revealed so far
+func (tw *TypewriterText) Revealed() string {
+	count := tw.RevealedCount()
+	for i := range tw.Text {
+		if count == 0 {
+			return tw.Text[:i]
+		}
+		count--
+	}
+	return tw.Text
+}
+
+//IsComplete returns true once all the text has been revealed
+func (tw *TypewriterText) IsComplete() bool {
+	return tw.RevealedCount() >= utf8.RuneCountInString(tw.Text)
+}
+
+//Draw draws the revealed text with the default font
+func (tw *TypewriterText) Draw(posX, posY, fontSize int, color Color) {
+	DrawText(tw.Revealed(), posX, posY, fontSize, color)
+}
+
+//DrawEx draws the revealed text with a font
+func (tw *TypewriterText) DrawEx(font Font, position Vector2, fontSize, spacing float32, tint Color) {
+	DrawTextEx(font, tw.Revealed(), position, fontSize, spacing, tint)
+}
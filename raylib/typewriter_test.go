@@ -0,0 +1,62 @@
+package raylib
+
+import "testing"
+
+func TestTypewriterReveal(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		steps    []string
+		complete bool
+	}{
+		{"ascii", "Hello", []string{"", "He", "Hell", "Hello", "Hello"}, true},
+		{"multibyte", "héllo世界", []string{"", "hé", "héll", "héllo世", "héllo世界"}, true},
+		{"emoji", "a🙂b🙂", []string{"", "a🙂", "a🙂b🙂", "a🙂b🙂", "a🙂b🙂"}, true},
+	}
+
+	for _, test := range tests {
+		//Every 0.25 seconds reveals 2 more characters
+		tw := NewTypewriterText(test.text, 8)
+		for i, expected := range test.steps {
+			if i > 0 {
+				tw.Update(0.25)
+			}
+			if revealed := tw.Revealed(); revealed != expected {
+				t.Errorf("%s: Revealed() after %v seconds = %q, want %q", test.name, float32(i)*0.25, revealed, expected)
+			}
+		}
+		if tw.IsComplete() != test.complete {
+			t.Errorf("%s: IsComplete() = %v, want %v", test.name, tw.IsComplete(), test.complete)
+		}
+	}
+}
+
+func TestTypewriterRevealedCount(t *testing.T) {
+	tw := NewTypewriterText("世界世界世界", 4)
+
+	counts := []int{0, 2, 4, 6, 6}
+	for i, expected := range counts {
+		if i > 0 {
+			tw.Update(0.5)
+		}
+		if count := tw.RevealedCount(); count != expected {
+			t.Errorf("RevealedCount() after %v seconds = %d, want %d", float32(i)*0.5, count, expected)
+		}
+	}
+
+	tw.Reset()
+	if count := tw.RevealedCount(); count != 0 || tw.IsComplete() {
+		t.Errorf("RevealedCount() after Reset() = %d, want 0", count)
+	}
+
+	tw.Skip()
+	if revealed := tw.Revealed(); revealed != tw.Text || !tw.IsComplete() {
+		t.Errorf("Revealed() after Skip() = %q, want all of the text", revealed)
+	}
+
+	tw.SetText("ab")
+	tw.CharsPerSecond = 0
+	if revealed := tw.Revealed(); revealed != "ab" {
+		t.Errorf("Revealed() with no speed = %q, want all of the text", revealed)
+	}
+}
@@ -1,13 +1,17 @@
 package raylib
 
+import "sync"
+
 //Unloadable is any object that has a Unload function and needs to be freed
 // when it has finished being used.
 type Unloadable interface {
 	Unload()
 }
 
-var unloadingAll bool = false
-var unloadables []Unloadable = make([]Unloadable, 100)
+var unloadables []Unloadable = make([]Unloadable, 0, 100)
+
+//unloadablesMutex guards unloadables, so resources can be loaded from other goroutines
+var unloadablesMutex sync.Mutex
 
 //TODO: Fix this
 func finalizeUnloadables(unlds *[]Unloadable) {
@@ -19,22 +23,26 @@ func finalizeUnloadables(unlds *[]Unloadable) {
 // This is called on Load functions
 func RegisterUnloadable(unloadable Unloadable) {
 	TraceLog(LogTrace, "[UNLOAD] New unloadable created")
+	unloadablesMutex.Lock()
 	unloadables = append(unloadables, unloadable)
+	unloadablesMutex.Unlock()
 }
 
 //UnregisterUnloadable unregisters an unloadable to the slice
 // This is called on Unload functions
-// This does not remove from the slice if unloadingAll is true (as that will clear post)
+// While UnloadAll is running, the unloadables it is unloading are no longer in the slice, so only
+// unloadables registered since then are removed.
 func UnregisterUnloadable(unloadable Unloadable) {
-	if !unloadingAll {
-		for i, u := range unloadables {
-			if u == unloadable {
-				unloadables[i] = unloadables[len(unloadables)-1]
-				unloadables[len(unloadables)-1] = nil
-				unloadables = unloadables[:len(unloadables)-1]
-				TraceLog(LogTrace, "[UNLOAD] Removed Unloadable")
-				break
-			}
+	unloadablesMutex.Lock()
+	defer unloadablesMutex.Unlock()
+
+	for i, u := range unloadables {
+		if u == unloadable {
+			unloadables[i] = unloadables[len(unloadables)-1]
+			unloadables[len(unloadables)-1] = nil
+			unloadables = unloadables[:len(unloadables)-1]
+			TraceLog(LogTrace, "[UNLOAD] Removed Unloadable")
+			break
 		}
 	}
 }
@@ -43,22 +51,85 @@ func UnregisterUnloadable(unloadable Unloadable) {
 // NOTE: Not everything maybe included in this list and it is experimental feature.
 // 			 Please unload these objects when you are not using them anyways.
 func UnloadAll() {
-	TraceLog(LogInfo, "[UNLOAD] Unloading all unloadables: ", len(unloadables))
+	//Take the unloadables and clear them. The lock is not held while unloading, as Unload will unregister itself.
+	unloadablesMutex.Lock()
+	pending := unloadables
+	unloadables = make([]Unloadable, 0, cap(pending))
+	unloadablesMutex.Unlock()
+
+	TraceLog(LogInfo, "[UNLOAD] Unloading all unloadables: ", len(pending))
 
 	//Count the tally
 	tally := 0
 
 	//Unload everything
-	unloadingAll = true
-	for _, ul := range unloadables {
+	for _, ul := range pending {
 		if ul != nil {
 			ul.Unload()
 			tally++
 		}
 	}
-	unloadingAll = false
 
-	//Clear the unloadables
-	unloadables = unloadables[:0]
 	TraceLog(LogInfo, "[UNLOAD] Unloaded ", tally)
 }
+
+//UnloadGroup tracks a set of unloadables so they can be unloaded together, such as every resource of a scene.
+// Adding to a group does not affect the global registry, and unloading a group leaves everything else loaded.
+type UnloadGroup struct {
+	mutex   sync.Mutex
+	members []Unloadable
+}
+
+//NewUnloadGroup creates a new empty group
+func NewUnloadGroup() *UnloadGroup {
+	return &UnloadGroup{members: make([]Unloadable, 0)}
+}
+
+//Add adds an unloadable to the group. Adding the same unloadable twice has no effect.
+func (group *UnloadGroup) Add(unloadable Unloadable) {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	for _, u := range group.members {
+		if u == unloadable {
+			return
+		}
+	}
+	group.members = append(group.members, unloadable)
+}
+
+//Remove removes an unloadable from the group without unloading it
+func (group *UnloadGroup) Remove(unloadable Unloadable) {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	for i, u := range group.members {
+		if u == unloadable {
+			group.members = append(group.members[:i], group.members[i+1:]...)
+			return
+		}
+	}
+}
+
+//Len is the number of unloadables in the group
+func (group *UnloadGroup) Len() int {
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+	return len(group.members)
+}
+
+//UnloadAll unloads every member of the group and clears it
+func (group *UnloadGroup) UnloadAll() {
+	//Like UnloadAll, the lock is not held while unloading
+	group.mutex.Lock()
+	pending := group.members
+	group.members = make([]Unloadable, 0)
+	group.mutex.Unlock()
+
+	TraceLog(LogInfo, "[UNLOAD] Unloading group: ", len(pending))
+	for _, ul := range pending {
+		if ul != nil {
+			ul.Unload()
+		}
+	}
+}
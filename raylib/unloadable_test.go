@@ -0,0 +1,188 @@
+package raylib
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+//fakeUnloadable counts how often it is unloaded, and unregisters itself like the generated Unload functions
+type fakeUnloadable struct {
+	unloads int32
+	onUnload func()
+}
+
+func (f *fakeUnloadable) Unload() {
+	atomic.AddInt32(&f.unloads, 1)
+	UnregisterUnloadable(f)
+	if f.onUnload != nil {
+		f.onUnload()
+	}
+}
+
+func (f *fakeUnloadable) unloadCount() int { return int(atomic.LoadInt32(&f.unloads)) }
+
+//isRegistered checks if the unloadable is in the global registry
+func isRegistered(unloadable Unloadable) bool {
+	unloadablesMutex.Lock()
+	defer unloadablesMutex.Unlock()
+	for _, u := range unloadables {
+		if u == unloadable {
+			return true
+		}
+	}
+	return false
+}
+
+//useEmptyUnloadables clears the global registry for the test, restoring it afterwards
+func useEmptyUnloadables(t *testing.T) {
+	unloadablesMutex.Lock()
+	previous := unloadables
+	unloadables = make([]Unloadable, 0, 100)
+	unloadablesMutex.Unlock()
+
+	t.Cleanup(func() {
+		unloadablesMutex.Lock()
+		unloadables = previous
+		unloadablesMutex.Unlock()
+	})
+}
+
+//registeredAtInit is the number of unloadables registered when the package is initialised, before any test can load something
+var registeredAtInit = len(unloadables)
+
+func TestUnloadablesEmptyBeforeLoad(t *testing.T) {
+	if registeredAtInit != 0 {
+		t.Errorf("%d unloadables registered before anything was loaded, want 0", registeredAtInit)
+	}
+}
+
+func TestUnloadAll(t *testing.T) {
+	useEmptyUnloadables(t)
+
+	first, second := &fakeUnloadable{}, &fakeUnloadable{}
+	RegisterUnloadable(first)
+	RegisterUnloadable(second)
+	UnregisterUnloadable(second)
+
+	UnloadAll()
+	if first.unloadCount() != 1 || second.unloadCount() != 0 {
+		t.Errorf("UnloadAll() unloaded %d and %d times, want 1 and 0", first.unloadCount(), second.unloadCount())
+	}
+	if len(unloadables) != 0 {
+		t.Errorf("UnloadAll() left %d unloadables", len(unloadables))
+	}
+}
+
+func TestUnregisterDuringUnloadAll(t *testing.T) {
+	useEmptyUnloadables(t)
+
+	//Something loaded and unloaded while UnloadAll is running must not be left behind
+	late := &fakeUnloadable{}
+	RegisterUnloadable(&fakeUnloadable{onUnload: func() {
+		RegisterUnloadable(late)
+		UnregisterUnloadable(late)
+	}})
+
+	UnloadAll()
+	if isRegistered(late) {
+		t.Error("unloadable unregistered during UnloadAll is still registered")
+	}
+}
+
+func TestUnloadablesConcurrent(t *testing.T) {
+	useEmptyUnloadables(t)
+
+	const workers = 8
+	const perWorker = 200
+
+	var wg sync.WaitGroup
+	fakes := make([][]*fakeUnloadable, workers)
+	for w := 0; w < workers; w++ {
+		fakes[w] = make([]*fakeUnloadable, perWorker)
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := range fakes[w] {
+				f := &fakeUnloadable{}
+				fakes[w][i] = f
+				RegisterUnloadable(f)
+				if i%2 == 0 {
+					UnregisterUnloadable(f)
+				}
+			}
+		}(w)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			UnloadAll()
+		}
+	}()
+	wg.Wait()
+
+	//Everything that is still registered is unloaded now
+	UnloadAll()
+	if len(unloadables) != 0 {
+		t.Fatalf("UnloadAll() left %d unloadables", len(unloadables))
+	}
+
+	for w := range fakes {
+		for i, f := range fakes[w] {
+			count := f.unloadCount()
+			if count > 1 {
+				t.Fatalf("unloadable unloaded %d times", count)
+			}
+
+			//Unregistered unloadables may have been unloaded before they were unregistered, but the rest must be unloaded
+			if i%2 == 1 && count != 1 {
+				t.Fatalf("registered unloadable was unloaded %d times, want 1", count)
+			}
+		}
+	}
+}
+
+func TestUnloadGroup(t *testing.T) {
+	useEmptyUnloadables(t)
+
+	global := &fakeUnloadable{}
+	RegisterUnloadable(global)
+
+	first, second, removed := &fakeUnloadable{}, &fakeUnloadable{}, &fakeUnloadable{}
+	group := NewUnloadGroup()
+	group.Add(first)
+	group.Add(second)
+	group.Add(first)
+	group.Add(removed)
+	if group.Len() != 3 {
+		t.Errorf("group has %d members after adding a duplicate, want 3", group.Len())
+	}
+
+	group.Remove(removed)
+	group.Remove(&fakeUnloadable{})
+	if group.Len() != 2 {
+		t.Errorf("group has %d members after removing one, want 2", group.Len())
+	}
+
+	group.UnloadAll()
+	if first.unloadCount() != 1 || second.unloadCount() != 1 {
+		t.Errorf("group members unloaded %d and %d times, want 1 each", first.unloadCount(), second.unloadCount())
+	}
+	if removed.unloadCount() != 0 || global.unloadCount() != 0 {
+		t.Error("group unloaded something that was not a member")
+	}
+	if group.Len() != 0 {
+		t.Errorf("group has %d members after unloading, want 0", group.Len())
+	}
+	if !isRegistered(global) {
+		t.Error("group unload removed an unloadable from the global registry")
+	}
+
+	//The group is empty, so unloading again does nothing
+	group.UnloadAll()
+	if first.unloadCount() != 1 {
+		t.Errorf("member unloaded %d times after unloading the group twice, want 1", first.unloadCount())
+	}
+}
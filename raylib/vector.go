@@ -1,6 +1,9 @@
 package raylib
 
-import "math"
+import (
+	"image"
+	"math"
+)
 
 //Vector a interface for any vector
 type Vector interface {
@@ -48,14 +51,20 @@ func (v Vector2) SqrLength() float32 {
 	return float32(float64(v.X*v.X) + float64(v.Y*v.Y))
 }
 
+//LengthSqr is the squared length of the vector, which is faster than Length for comparing lengths. Same as SqrLength.
+func (v Vector2) LengthSqr() float32 { return v.SqrLength() }
+
 //DotProduct of the vector
 func (v Vector2) DotProduct(v2 Vector2) float32 {
 	return v.X*v2.X + v.Y*v2.Y
 }
 
-//Perpendicular to this vector
+//Dot is the dot product of the vectors. Same as DotProduct.
+func (v Vector2) Dot(v2 Vector2) float32 { return v.DotProduct(v2) }
+
+//Perpendicular to this vector, rotated 90 degrees counter-clockwise (clockwise on the screen as Y points down)
 func (v Vector2) Perpendicular() Vector2 {
-	return Vector2{X: v.Y, Y: v.X}
+	return Vector2{X: -v.Y, Y: v.X}
 }
 
 //RotateByRadians rotates the vector in radians. Use Deg2Rad to convert degress into radians.
@@ -68,6 +77,9 @@ func (v Vector2) RotateByRadians(radians float32) Vector2 {
 	}
 }
 
+//Rotate the vector in radians. Same as RotateByRadians.
+func (v Vector2) Rotate(radians float32) Vector2 { return v.RotateByRadians(radians) }
+
 //Angle the vector creates with another vector
 func (v Vector2) Angle(v2 Vector2) float32 {
 	result := float32(math.Atan2(float64(v2.Y-v.Y), float64(v2.X-v.X))) * Rad2Deg
@@ -84,7 +96,7 @@ func (v Vector2) Scale(scale float32) Vector2 {
 
 //Multiply a vector by another vector
 func (v Vector2) Multiply(v2 Vector2) Vector2 {
-	return Vector2{X: v.X * v2.Y, Y: v.Y * v2.Y}
+	return Vector2{X: v.X * v2.X, Y: v.Y * v2.Y}
 }
 
 //Negate or Inverts a vector
@@ -99,12 +111,16 @@ func (v Vector2) Divide(d float32) Vector2 {
 
 //DivideV a vector by another vecotr (v / v2)
 func (v Vector2) DivideV(v2 Vector2) Vector2 {
-	return Vector2{X: v.X / v2.Y, Y: v.Y / v2.Y}
+	return Vector2{X: v.X / v2.X, Y: v.Y / v2.Y}
 }
 
-//Normalize a vector
+//Normalize a vector. A zero vector stays zero.
 func (v Vector2) Normalize() Vector2 {
-	return v.Divide(v.Length())
+	length := v.Length()
+	if length == 0 {
+		return Vector2{}
+	}
+	return v.Divide(length)
 }
 
 //Lerp a vector towards another vector
@@ -115,6 +131,31 @@ func (v Vector2) Lerp(target Vector2, amount float32) Vector2 {
 	}
 }
 
+//SmoothDamp gradually moves the vector towards the target like a critically damped spring, never overshooting.
+// The velocity is an accumulator that is modified by every call, so keep it between calls. See SmoothDamp.
+func (v Vector2) SmoothDamp(target Vector2, velocity *Vector2, smoothTime, dt float32) Vector2 {
+	if smoothTime < 0.0001 {
+		smoothTime = 0.0001
+	}
+
+	omega := 2 / smoothTime
+	x := omega * dt
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := v.Subtract(target)
+	temp := velocity.Add(change.Scale(omega)).Scale(dt)
+	*velocity = velocity.Subtract(temp.Scale(omega)).Scale(exp)
+	output := target.Add(change.Add(temp).Scale(exp))
+
+	//Prevent overshooting
+	if target.Subtract(v).DotProduct(output.Subtract(target)) > 0 {
+		output = target
+		*velocity = NewVector2Zero()
+	}
+
+	return output
+}
+
 //Distance between two vectors
 func (v Vector2) Distance(v2 Vector2) float32 {
 	d := v2.Subtract(v)
@@ -123,7 +164,7 @@ func (v Vector2) Distance(v2 Vector2) float32 {
 
 //Reflect a vector. The mirror normal can be invisioned as a mirror perpendicular to the surface that is hit.
 func (v Vector2) Reflect(mirrorNormal Vector2) Vector2 {
-	return v.Add(v.Scale(-2 * v.DotProduct(mirrorNormal)))
+	return v.Subtract(mirrorNormal.Scale(2 * v.DotProduct(mirrorNormal)))
 }
 
 //Min value for each pair of components
@@ -142,6 +183,28 @@ func (v Vector2) Max(v2 Vector2) Vector2 {
 	}
 }
 
+//Clamp limits each component between the components of min and max.
+// If a component of min is larger than max, they are swapped.
+func (v Vector2) Clamp(min, max Vector2) Vector2 {
+	return v.Max(min.Min(max)).Min(min.Max(max))
+}
+
+//Vector2Min gets the min value for each pair of components
+func Vector2Min(a, b Vector2) Vector2 { return a.Min(b) }
+
+//Vector2Max gets the max value for each pair of components
+func Vector2Max(a, b Vector2) Vector2 { return a.Max(b) }
+
+//Vector2FromImagePoint creates a vector from an image.Point
+func Vector2FromImagePoint(point image.Point) Vector2 {
+	return NewVector2(float32(point.X), float32(point.Y))
+}
+
+//ToImagePoint converts the vector into an image.Point, rounding each component to the nearest integer
+func (v Vector2) ToImagePoint() image.Point {
+	return image.Pt(int(math.Round(float64(v.X))), int(math.Round(float64(v.Y))))
+}
+
 //ToVector3 converts this vector2 into a vector3
 func (v Vector2) ToVector3() Vector3 { return NewVector3(v.X, v.Y, 0) }
 
@@ -340,6 +403,18 @@ func (v Vector3) Max(v2 Vector3) Vector3 {
 	}
 }
 
+//Clamp limits each component between the components of min and max.
+// If a component of min is larger than max, they are swapped.
+func (v Vector3) Clamp(min, max Vector3) Vector3 {
+	return v.Max(min.Min(max)).Min(min.Max(max))
+}
+
+//Vector3Min gets the min value for each pair of components
+func Vector3Min(a, b Vector3) Vector3 { return a.Min(b) }
+
+//Vector3Max gets the max value for each pair of components
+func Vector3Max(a, b Vector3) Vector3 { return a.Max(b) }
+
 //QuaternionToo calculates the Quaternion between the current vector and the next
 func (v Vector3) QuaternionToo(v2 Vector3) Quaternion {
 	return NewQuaternionVector3ToVector3(v, v2)
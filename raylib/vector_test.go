@@ -0,0 +1,172 @@
+package raylib
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+const testEpsilon = 1e-4
+
+//nearlyEqual checks if two floats are equal within testEpsilon
+func nearlyEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) <= testEpsilon
+}
+
+//vector2NearlyEqual checks if each component of the vectors are equal within testEpsilon
+func vector2NearlyEqual(a, b Vector2) bool {
+	return nearlyEqual(a.X, b.X) && nearlyEqual(a.Y, b.Y)
+}
+
+func TestVector2Operations(t *testing.T) {
+	a := NewVector2(3, 4)
+	b := NewVector2(2, -1)
+
+	tests := []struct {
+		name     string
+		actual   Vector2
+		expected Vector2
+	}{
+		{"Add", a.Add(b), NewVector2(5, 3)},
+		{"Subtract", a.Subtract(b), NewVector2(1, 5)},
+		{"Scale", a.Scale(2), NewVector2(6, 8)},
+		{"Multiply", a.Multiply(b), NewVector2(6, -4)},
+		{"Negate", a.Negate(), NewVector2(-3, -4)},
+		{"Divide", a.Divide(2), NewVector2(1.5, 2)},
+		{"DivideV", a.DivideV(b), NewVector2(1.5, -4)},
+		{"Normalize", a.Normalize(), NewVector2(0.6, 0.8)},
+		{"Normalize zero", NewVector2Zero().Normalize(), NewVector2Zero()},
+		{"Perpendicular", a.Perpendicular(), NewVector2(-4, 3)},
+		{"Perpendicular right", NewVector2Right().Perpendicular(), NewVector2Up()},
+		{"RotateByRadians", NewVector2Right().RotateByRadians(math.Pi / 2), NewVector2Up()},
+		{"Rotate", a.Rotate(math.Pi), NewVector2(-3, -4)},
+		{"Lerp start", a.Lerp(b, 0), a},
+		{"Lerp half", a.Lerp(b, 0.5), NewVector2(2.5, 1.5)},
+		{"Lerp end", a.Lerp(b, 1), b},
+		{"Reflect floor", NewVector2(1, -1).Reflect(NewVector2Up()), NewVector2(1, 1)},
+		{"Reflect wall", NewVector2(2, 3).Reflect(NewVector2(-1, 0)), NewVector2(-2, 3)},
+		{"Min", a.Min(b), NewVector2(2, -1)},
+		{"Max", a.Max(b), NewVector2(3, 4)},
+	}
+
+	for _, test := range tests {
+		if !vector2NearlyEqual(test.actual, test.expected) {
+			t.Errorf("%s = %v, want %v", test.name, test.actual, test.expected)
+		}
+	}
+}
+
+func TestVector2Scalars(t *testing.T) {
+	a := NewVector2(3, 4)
+	b := NewVector2(2, -1)
+
+	tests := []struct {
+		name     string
+		actual   float32
+		expected float32
+	}{
+		{"Length", a.Length(), 5},
+		{"Length zero", NewVector2Zero().Length(), 0},
+		{"SqrLength", a.SqrLength(), 25},
+		{"LengthSqr", a.LengthSqr(), 25},
+		{"DotProduct", a.DotProduct(b), 2},
+		{"Dot", a.Dot(b), 2},
+		{"Dot perpendicular", a.Dot(a.Perpendicular()), 0},
+		{"Distance", a.Distance(b), float32(math.Sqrt(26))},
+		{"Angle right", NewVector2Zero().Angle(NewVector2Right()), 0},
+		{"Angle up", NewVector2Zero().Angle(NewVector2Up()), 90},
+		{"Angle negative", NewVector2Zero().Angle(NewVector2(0, -1)), 270},
+		{"Normalize length", NewVector2(-7, 24).Normalize().Length(), 1},
+	}
+
+	for _, test := range tests {
+		if !nearlyEqual(test.actual, test.expected) {
+			t.Errorf("%s = %v, want %v", test.name, test.actual, test.expected)
+		}
+	}
+}
+
+func TestVector2NormalizeZeroIsNotNaN(t *testing.T) {
+	normal := NewVector2Zero().Normalize()
+	if math.IsNaN(float64(normal.X)) || math.IsNaN(float64(normal.Y)) {
+		t.Errorf("Normalize() of a zero vector = %v, want no NaNs", normal)
+	}
+}
+
+func TestVectorMinMaxClamp(t *testing.T) {
+	a2, b2 := NewVector2(1, 5), NewVector2(3, -2)
+	tests2 := []struct {
+		name     string
+		actual   Vector2
+		expected Vector2
+	}{
+		{"Vector2Min", Vector2Min(a2, b2), NewVector2(1, -2)},
+		{"Vector2Max", Vector2Max(a2, b2), NewVector2(3, 5)},
+		{"Clamp inside", NewVector2(2, 3).Clamp(NewVector2(0, 0), NewVector2(4, 4)), NewVector2(2, 3)},
+		{"Clamp per component", NewVector2(-1, 9).Clamp(NewVector2(0, 0), NewVector2(4, 4)), NewVector2(0, 4)},
+		{"Clamp swapped", NewVector2(-1, 9).Clamp(NewVector2(4, 4), NewVector2(0, 0)), NewVector2(0, 4)},
+		{"Clamp one axis swapped", NewVector2(10, 10).Clamp(NewVector2(0, 8), NewVector2(4, 2)), NewVector2(4, 8)},
+	}
+	for _, test := range tests2 {
+		if test.actual != test.expected {
+			t.Errorf("%s = %v, want %v", test.name, test.actual, test.expected)
+		}
+	}
+
+	a3, b3 := NewVector3(1, 5, -3), NewVector3(3, -2, -1)
+	tests3 := []struct {
+		name     string
+		actual   Vector3
+		expected Vector3
+	}{
+		{"Vector3Min", Vector3Min(a3, b3), NewVector3(1, -2, -3)},
+		{"Vector3Max", Vector3Max(a3, b3), NewVector3(3, 5, -1)},
+		{"Clamp per component", NewVector3(-1, 2, 9).Clamp(NewVector3(0, 0, 0), NewVector3(4, 4, 4)), NewVector3(0, 2, 4)},
+		{"Clamp swapped", NewVector3(-1, 2, 9).Clamp(NewVector3(4, 4, 4), NewVector3(0, 0, 0)), NewVector3(0, 2, 4)},
+	}
+	for _, test := range tests3 {
+		if test.actual != test.expected {
+			t.Errorf("%s = %v, want %v", test.name, test.actual, test.expected)
+		}
+	}
+
+	clamps := []struct {
+		v, min, max, expected float32
+	}{
+		{5, 0, 10, 5},
+		{-5, 0, 10, 0},
+		{15, 0, 10, 10},
+		{10, 0, 10, 10},
+	}
+	for _, test := range clamps {
+		if actual := Clamp32(test.v, test.min, test.max); actual != test.expected {
+			t.Errorf("Clamp32(%v, %v, %v) = %v, want %v", test.v, test.min, test.max, actual, test.expected)
+		}
+		if actual := Clamp(float64(test.v), float64(test.min), float64(test.max)); actual != float64(test.expected) {
+			t.Errorf("Clamp(%v, %v, %v) = %v, want %v", test.v, test.min, test.max, actual, test.expected)
+		}
+	}
+}
+
+func TestImagePointRoundTrip(t *testing.T) {
+	for _, point := range []image.Point{image.Pt(0, 0), image.Pt(3, -7), image.Pt(-120, 640)} {
+		if got := Vector2FromImagePoint(point).ToImagePoint(); got != point {
+			t.Errorf("Vector2FromImagePoint(%v).ToImagePoint() = %v, want %v", point, got, point)
+		}
+	}
+
+	//Fractional vectors round to the nearest point
+	tests := []struct {
+		vector Vector2
+		want   image.Point
+	}{
+		{NewVector2(1.4, 1.6), image.Pt(1, 2)},
+		{NewVector2(-1.4, -1.6), image.Pt(-1, -2)},
+		{NewVector2(2.5, -2.5), image.Pt(3, -3)},
+	}
+	for _, test := range tests {
+		if got := test.vector.ToImagePoint(); got != test.want {
+			t.Errorf("%v.ToImagePoint() = %v, want %v", test.vector, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package raylib
+
+/*
+Simple immediate mode widgets drawn with the shape and text functions, for tools that do not want to use raygui.
+*/
+
+//WidgetFontSize is the font size used by the widgets
+var WidgetFontSize = 10
+
+//ListItemHeight is the height of each item in a ListView
+var ListItemHeight float32 = 24
+
+//Dropdown draws a box showing the selected option that opens a list of all the options when clicked.
+// Clicking an option selects it and closes the list, as does clicking anywhere else. Returns true if the selection changed.
+// The open list is drawn below the bounds, so the dropdown should be drawn after anything it may cover.
+func Dropdown(bounds Rectangle, options []string, selected *int, open *bool) bool {
+	mouse := GetMousePosition()
+	clicked := IsMouseButtonPressed(MouseLeftButton)
+	changed := false
+
+	//Header showing the current option
+	header := ""
+	if *selected >= 0 && *selected < len(options) {
+		header = options[*selected]
+	}
+	drawWidgetItem(bounds, header, CheckCollisionPointRec(mouse, bounds) || *open, false)
+	DrawText("v", int(bounds.X+bounds.Width)-WidgetFontSize-4, int(bounds.Y+(bounds.Height-float32(WidgetFontSize))/2), WidgetFontSize, DarkGray)
+
+	if !*open {
+		if clicked && CheckCollisionPointRec(mouse, bounds) {
+			*open = true
+		}
+		return false
+	}
+
+	//The list of options below the header
+	list := NewRectangle(bounds.X, bounds.Y+bounds.Height, bounds.Width, bounds.Height*float32(len(options)))
+	hovered := -1
+	if CheckCollisionPointRec(mouse, list) {
+		hovered = widgetItemAt(list.Y, bounds.Height, 0, mouse.Y, len(options))
+	}
+
+	for i, option := range options {
+		item := NewRectangle(list.X, list.Y+bounds.Height*float32(i), bounds.Width, bounds.Height)
+		drawWidgetItem(item, option, i == hovered, i == *selected)
+	}
+
+	if clicked {
+		if hovered >= 0 && hovered != *selected {
+			*selected = hovered
+			changed = true
+		}
+		*open = false
+	}
+
+	return changed
+}
+
+//ListView draws a scrollable list of items where one can be made active by clicking it.
+// The scroll is the distance in pixels the list has been scrolled, and is updated by the mouse wheel while hovered.
+// Returns true if the active item changed.
+func ListView(bounds Rectangle, items []string, scroll *float32, active *int) bool {
+	mouse := GetMousePosition()
+	hovering := CheckCollisionPointRec(mouse, bounds)
+	changed := false
+
+	//Scroll with the wheel, but never past the ends
+	if hovering {
+		*scroll -= float32(GetMouseWheelMove()) * ListItemHeight
+	}
+	maxScroll := ListItemHeight*float32(len(items)) - bounds.Height
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	*scroll = Clamp32(*scroll, 0, maxScroll)
+
+	hovered := -1
+	if hovering {
+		hovered = widgetItemAt(bounds.Y, ListItemHeight, *scroll, mouse.Y, len(items))
+	}
+
+	if hovered >= 0 && IsMouseButtonPressed(MouseLeftButton) && hovered != *active {
+		*active = hovered
+		changed = true
+	}
+
+	DrawRectangleRec(bounds, RayWhite)
+	BeginScissorMode(int(bounds.X), int(bounds.Y), int(bounds.Width), int(bounds.Height))
+	for i, text := range items {
+		item := NewRectangle(bounds.X, bounds.Y+ListItemHeight*float32(i)-*scroll, bounds.Width, ListItemHeight)
+		if item.Y+item.Height < bounds.Y || item.Y > bounds.Y+bounds.Height {
+			continue
+		}
+		drawWidgetItem(item, text, i == hovered, i == *active)
+	}
+	EndScissorMode()
+	DrawRectangleLinesEx(bounds, 1, Gray)
+
+	return changed
+}
+
+//widgetItemAt finds the index of the item under the y position, for a list of items starting at the top and scrolled down.
+// Returns -1 if there is no item at the position.
+func widgetItemAt(top, itemHeight, scroll, y float32, count int) int {
+	if itemHeight <= 0 {
+		return -1
+	}
+
+	offset := y - top + scroll
+	if offset < 0 {
+		return -1
+	}
+
+	index := int(offset / itemHeight)
+	if index >= count {
+		return -1
+	}
+	return index
+}
+
+//drawWidgetItem draws a single item box with its text
+func drawWidgetItem(bounds Rectangle, text string, hovered, selected bool) {
+	background := RayWhite
+	if selected {
+		background = SkyBlue
+	} else if hovered {
+		background = LightGray
+	}
+
+	DrawRectangleRec(bounds, background)
+	DrawRectangleLinesEx(bounds, 1, Gray)
+	DrawText(text, int(bounds.X)+4, int(bounds.Y+(bounds.Height-float32(WidgetFontSize))/2), WidgetFontSize, DarkGray)
+}
@@ -0,0 +1,30 @@
+package raylib
+
+import "testing"
+
+func TestWidgetItemAt(t *testing.T) {
+	tests := []struct {
+		name                       string
+		top, itemHeight, scroll, y float32
+		count                      int
+		expected                   int
+	}{
+		{"first item", 100, 20, 0, 100, 5, 0},
+		{"bottom of first item", 100, 20, 0, 119.9, 5, 0},
+		{"second item", 100, 20, 0, 120, 5, 1},
+		{"last item", 100, 20, 0, 199, 5, 4},
+		{"past the last item", 100, 20, 0, 200, 5, -1},
+		{"above the list", 100, 20, 0, 99, 5, -1},
+		{"scrolled", 100, 20, 30, 100, 5, 1},
+		{"scrolled to the end", 100, 20, 20, 170, 5, 4},
+		{"scrolled past the end", 100, 20, 60, 180, 5, -1},
+		{"no items", 100, 20, 0, 100, 0, -1},
+		{"no item height", 100, 0, 0, 100, 5, -1},
+	}
+
+	for _, test := range tests {
+		if actual := widgetItemAt(test.top, test.itemHeight, test.scroll, test.y, test.count); actual != test.expected {
+			t.Errorf("%s: widgetItemAt() = %d, want %d", test.name, actual, test.expected)
+		}
+	}
+}
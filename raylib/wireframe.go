@@ -0,0 +1,47 @@
+package raylib
+
+/*
+#include "raylib.h"
+#include "rlgl.h"
+
+//Flushes anything already batched so it is drawn in the current mode, then changes the mode.
+static void Go_SetWireMode(bool enabled) {
+	rlglDraw();
+	if (enabled) rlEnableWireMode();
+	else rlDisableWireMode();
+}
+*/
+import "C"
+
+var wireframeMode = false
+
+//SetWireframeMode makes everything drawn afterwards be drawn as wireframe, until it is disabled again.
+// This is not available on OpenGL ES, where it does nothing.
+func SetWireframeMode(enabled bool) {
+	wireframeMode = enabled
+	setWireMode(enabled)
+}
+
+//setWireMode changes the OpenGL wire mode without changing the mode set by SetWireframeMode
+func setWireMode(enabled bool) {
+	C.Go_SetWireMode(C.bool(enabled))
+}
+
+//IsWireframeMode returns true if wireframe mode has been enabled with SetWireframeMode
+func IsWireframeMode() bool { return wireframeMode }
+
+//DrawWithWireframe draws the model solid, then draws its wireframe over the top in another colour.
+// The wireframe mode set by SetWireframeMode is restored afterwards.
+func (model *Model) DrawWithWireframe(position Vector3, scale float32, tint, wireColor Color) {
+	drawWithWireframe(wireframeMode, setWireMode,
+		func() { DrawModel(*model, position, scale, tint) },
+		func() { DrawModelWires(*model, position, scale, wireColor) })
+}
+
+//drawWithWireframe draws the solid then the wires with the wire mode off, then sets the wire mode back to restore
+func drawWithWireframe(restore bool, setWireMode func(bool), drawSolid, drawWires func()) {
+	setWireMode(false)
+	drawSolid()
+	drawWires()
+	setWireMode(restore)
+}
@@ -0,0 +1,35 @@
+package raylib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDrawWithWireframe(t *testing.T) {
+	tests := []struct {
+		restore  bool
+		expected []string
+	}{
+		{false, []string{"wire off", "solid", "wires", "wire off"}},
+		{true, []string{"wire off", "solid", "wires", "wire on"}},
+	}
+
+	for _, test := range tests {
+		calls := []string{}
+		setWireMode := func(enabled bool) {
+			if enabled {
+				calls = append(calls, "wire on")
+			} else {
+				calls = append(calls, "wire off")
+			}
+		}
+
+		drawWithWireframe(test.restore, setWireMode,
+			func() { calls = append(calls, "solid") },
+			func() { calls = append(calls, "wires") })
+
+		if !reflect.DeepEqual(calls, test.expected) {
+			t.Errorf("drawWithWireframe() restoring %v made the calls %v, want %v", test.restore, calls, test.expected)
+		}
+	}
+}